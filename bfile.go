@@ -0,0 +1,258 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+/*
+#include <stdlib.h>
+#include "dpiImpl.h"
+*/
+import "C"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+var _ = sql.Scanner((*BFile)(nil))
+
+// BFile is a BFILE locator: a directory alias and a file name.
+//
+// It can be used as a bind value (also as sql.Out{Dest: *BFile} and in slices),
+// and as a Scan destination for BFILE columns.
+//
+// A BFile got from the database (or from Conn.NewBFile) holds a locator,
+// that must be released with Close.
+type BFile struct {
+	drv       *drv
+	dpiLob    *C.dpiLob
+	Dir, Name string
+}
+
+// ErrNoLocator is returned when a BFile operation needs a locator, but the BFile does not have one.
+var ErrNoLocator = errors.New("BFile has no locator: get it from the database or use Conn.NewBFile")
+
+// NewBFile returns a BFile (with a locator) for the given directory alias and file name.
+func (c *conn) NewBFile(dir, name string) (*BFile, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	dv, data, err := c.newVar(varInfo{Typ: C.DPI_ORACLE_TYPE_BFILE, NatTyp: C.DPI_NATIVE_TYPE_LOB})
+	if err != nil {
+		return nil, err
+	}
+	defer C.dpiVar_release(dv)
+	lob := C.dpiData_getLOB(&data[0])
+	if err := setBFileName(c.drv, lob, dir, name); err != nil {
+		return nil, err
+	}
+	if err := c.checkExecNoLOT(func() C.int { return C.dpiLob_addRef(lob) }); err != nil {
+		return nil, fmt.Errorf("addRef: %w", err)
+	}
+	return &BFile{drv: c.drv, dpiLob: lob, Dir: dir, Name: name}, nil
+}
+
+// Scan a BFILE column (a *Lob).
+func (f *BFile) Scan(src interface{}) error {
+	// release the previous locator of the reused destination
+	if err := f.Close(); err != nil {
+		return err
+	}
+	switch x := src.(type) {
+	case nil:
+		*f = BFile{}
+		return nil
+	case *Lob:
+		if x == nil || x.Reader == nil {
+			*f = BFile{}
+			return nil
+		}
+		lr, ok := x.Reader.(*dpiLobReader)
+		if !ok {
+			return fmt.Errorf("Lob.Reader is %T, not *dpiLobReader", x.Reader)
+		}
+		return f.fromLob(lr.drv, lr.dpiLob, true)
+	default:
+		return fmt.Errorf("cannot scan %T into BFile", src)
+	}
+}
+
+// fromLob fills the BFile from the locator, copying it if asked.
+func (f *BFile) fromLob(d *drv, lob *C.dpiLob, doCopy bool) error {
+	*f = BFile{drv: d}
+	dl := DirectLob{drv: d, dpiLob: lob}
+	var err error
+	if f.Dir, f.Name, err = dl.GetFileName(); err != nil {
+		return err
+	}
+	if doCopy {
+		// the locator is reused by the next fetch
+		if err = d.checkExec(func() C.int { return C.dpiLob_copy(lob, &f.dpiLob) }); err != nil {
+			return fmt.Errorf("copy: %w", err)
+		}
+		return nil
+	}
+	if err = d.checkExec(func() C.int { return C.dpiLob_addRef(lob) }); err != nil {
+		return fmt.Errorf("addRef: %w", err)
+	}
+	f.dpiLob = lob
+	return nil
+}
+
+// IsZero reports whether the BFile is zero (NULL).
+func (f BFile) IsZero() bool { return f.Dir == "" && f.Name == "" && f.dpiLob == nil }
+
+// Exists reports whether the file exists on the server.
+func (f *BFile) Exists() (bool, error) {
+	if f.dpiLob == nil {
+		return false, ErrNoLocator
+	}
+	var exists C.int
+	if err := f.drv.checkExec(func() C.int { return C.dpiLob_getFileExists(f.dpiLob, &exists) }); err != nil {
+		return false, fmt.Errorf("getFileExists: %w", err)
+	}
+	return exists == 1, nil
+}
+
+// Open the file for reading.
+func (f *BFile) Open() error {
+	if f.dpiLob == nil {
+		return ErrNoLocator
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var isOpen C.int
+	if C.dpiLob_getIsResourceOpen(f.dpiLob, &isOpen) != C.DPI_FAILURE && isOpen == 1 {
+		return nil
+	}
+	if err := f.drv.checkExecNoLOT(func() C.int { return C.dpiLob_openResource(f.dpiLob) }); err != nil {
+		return fmt.Errorf("openResource(%s/%s): %w", f.Dir, f.Name, err)
+	}
+	return nil
+}
+
+// Size returns the size of the file, in bytes.
+func (f *BFile) Size() (int64, error) {
+	if f.dpiLob == nil {
+		return 0, ErrNoLocator
+	}
+	dl := DirectLob{drv: f.drv, dpiLob: f.dpiLob}
+	return dl.Size()
+}
+
+// NewReader returns a Lob that streams the contents of the file.
+//
+// The reader has its own (opened) copy of the locator, released at EOF or on its Close,
+// so the BFile remains usable, and can be Closed independently.
+func (f *BFile) NewReader() (*Lob, error) {
+	if f.dpiLob == nil {
+		return nil, ErrNoLocator
+	}
+	var lob *C.dpiLob
+	if err := f.drv.checkExec(func() C.int { return C.dpiLob_copy(f.dpiLob, &lob) }); err != nil {
+		return nil, fmt.Errorf("copy: %w", err)
+	}
+	if err := f.drv.checkExec(func() C.int { return C.dpiLob_openResource(lob) }); err != nil {
+		_ = closeLob(f.drv, lob)
+		return nil, fmt.Errorf("openResource(%s/%s): %w", f.Dir, f.Name, err)
+	}
+	return &Lob{Reader: &dpiLobReader{drv: f.drv, dpiLob: lob}}, nil
+}
+
+// Close the file (if opened) and release the locator.
+func (f *BFile) Close() error {
+	if f == nil || f.dpiLob == nil {
+		return nil
+	}
+	lob := f.dpiLob
+	f.dpiLob = nil
+	return closeLob(f.drv, lob)
+}
+
+func setBFileName(d *drv, lob *C.dpiLob, dir, name string) error {
+	cDir, cName := C.CString(dir), C.CString(name)
+	defer func() { C.free(unsafe.Pointer(cDir)); C.free(unsafe.Pointer(cName)) }()
+	if err := d.checkExecNoLOT(func() C.int {
+		return C.dpiLob_setDirectoryAndFileName(lob, cDir, C.uint32_t(len(dir)), cName, C.uint32_t(len(name)))
+	}); err != nil {
+		return fmt.Errorf("setDirectoryAndFileName(%q, %q): %w", dir, name, err)
+	}
+	return nil
+}
+
+func (c *conn) dataSetBFile(ctx context.Context, dv *C.dpiVar, data []C.dpiData, vv interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if vv == nil {
+		return dataSetNull(ctx, dv, data, nil)
+	}
+	var files []BFile
+	switch x := vv.(type) {
+	case BFile:
+		files = []BFile{x}
+	case []BFile:
+		files = x
+	default:
+		return fmt.Errorf("dataSetBFile(%T): %w", vv, errUnknownType)
+	}
+	for i, f := range files {
+		if f.Dir == "" && f.Name == "" {
+			data[i].isNull = 1
+			continue
+		}
+		data[i].isNull = 0
+		if err := setBFileName(c.drv, C.dpiData_getLOB(&data[i]), f.Dir, f.Name); err != nil {
+			return fmt.Errorf("%d. %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *conn) dataGetBFile(ctx context.Context, v interface{}, data []C.dpiData) error {
+	get := func(f *BFile, d *C.dpiData) error {
+		// release the previous locator of the reused destination
+		if err := f.Close(); err != nil {
+			return err
+		}
+		if d.isNull == 1 {
+			*f = BFile{}
+			return nil
+		}
+		return f.fromLob(c.drv, C.dpiData_getLOB(d), false)
+	}
+	if f, ok := v.(*BFile); ok {
+		if len(data) == 0 {
+			err := f.Close()
+			*f = BFile{}
+			return err
+		}
+		return get(f, &data[0])
+	}
+	slice, ok := v.(*[]BFile)
+	if !ok {
+		return fmt.Errorf("dataGetBFile(%T): %w", v, errUnknownType)
+	}
+	n := len(data)
+	for i := n; i < len(*slice); i++ {
+		if err := (*slice)[i].Close(); err != nil {
+			return fmt.Errorf("%d. %w", i, err)
+		}
+	}
+	if cap(*slice) >= n {
+		*slice = (*slice)[:n]
+	} else {
+		// keep the old elements, so get closes their locators
+		*slice = append(*slice, make([]BFile, n-len(*slice))...)
+	}
+	for i := range data {
+		if err := get(&((*slice)[i]), &data[i]); err != nil {
+			return fmt.Errorf("%d. %w", i, err)
+		}
+	}
+	return nil
+}
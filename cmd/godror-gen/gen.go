@@ -0,0 +1,785 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	godror "github.com/godror/godror"
+)
+
+// Type is the serializable description of an object or collection type,
+// or of the scalar type of an attribute or collection element.
+type Type struct {
+	CollectionOf *Type  `json:",omitempty"`
+	Schema       string `json:",omitempty"`
+	Package      string `json:",omitempty"`
+	Name         string `json:",omitempty"`
+	DBType       string // NUMBER, VARCHAR2, ..., OBJECT
+	Attributes   []Attr `json:",omitempty"`
+	Precision    int16  `json:",omitempty"`
+	Scale        int8   `json:",omitempty"`
+	Length       int    `json:",omitempty"`
+}
+
+// Attr is an attribute of an object type.
+type Attr struct {
+	Type *Type
+	Name string
+}
+
+// IsObject reports whether the type is an object or a collection.
+func (t *Type) IsObject() bool { return t.DBType == "OBJECT" }
+
+// FullName returns the name of the type, as godror.GetObjectType accepts it.
+func (t *Type) FullName() string {
+	var parts []string
+	for _, s := range []string{t.Schema, t.Package, t.Name} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// fromDescription converts the godror.ObjectTypeDescription to Type, recursively.
+func fromDescription(d godror.ObjectTypeDescription) *Type {
+	t := Type{DBType: d.DatabaseType}
+	if !d.IsObject() {
+		switch {
+		case t.DBType == "NUMBER":
+			t.Precision, t.Scale = d.Precision, d.Scale
+		case strings.Contains(t.DBType, "CHAR"):
+			t.Length = d.CharSize
+		case t.DBType == "RAW":
+			t.Length = d.DBSize
+		}
+		return &t
+	}
+	t.Schema, t.Package, t.Name = d.Schema, d.Package, d.Name
+	if d.CollectionOf != nil {
+		t.CollectionOf = fromDescription(*d.CollectionOf)
+		return &t
+	}
+	for _, a := range d.Attributes {
+		t.Attributes = append(t.Attributes, Attr{Name: a.Name, Type: fromDescription(a.Type)})
+	}
+	return &t
+}
+
+// Generate returns the formatted Go source of the package with the types
+// (and the object and collection types they reference).
+func Generate(pkg string, types []*Type) ([]byte, error) {
+	g := newGenerator()
+	for _, t := range types {
+		if !t.IsObject() {
+			return nil, fmt.Errorf("%s: %s is not an object or collection type", t.FullName(), t.DBType)
+		}
+		g.collect(t)
+	}
+	if err := g.genTypes(); err != nil {
+		return nil, err
+	}
+	return g.source(pkg)
+}
+
+// GeneratePlsql returns the formatted Go source of the package with the functions calling
+// the procedures and functions of the PL/SQL packages (and the types of their arguments).
+//
+// The OUT index-by tables can have at most arraySize elements.
+func GeneratePlsql(pkg string, pkgs []*PlsqlPackage, arraySize int) ([]byte, error) {
+	g := newGenerator()
+	for _, p := range pkgs {
+		for _, proc := range p.Procs {
+			if proc.Return != nil {
+				g.collect(proc.Return)
+			}
+			for _, a := range proc.Args {
+				g.collect(a.Type)
+			}
+		}
+	}
+	if err := g.genTypes(); err != nil {
+		return nil, err
+	}
+	for _, p := range pkgs {
+		for _, proc := range p.Procs {
+			if err := g.genProc(*p, proc, arraySize); err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", p.FullName(), proc.Name, err)
+			}
+		}
+	}
+	return g.source(pkg)
+}
+
+func newGenerator() *generator {
+	return &generator{
+		names:   make(map[string]string),
+		helpers: make(map[string]bool),
+		imports: map[string]bool{"context": true, "fmt": true},
+	}
+}
+
+// genTypes generates the collected types.
+func (g *generator) genTypes() error {
+	for _, t := range g.types {
+		var err error
+		if t.CollectionOf != nil {
+			err = g.genCollection(t)
+		} else {
+			err = g.genObject(t)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.FullName(), err)
+		}
+	}
+	return nil
+}
+
+// source returns the formatted source of the generated code.
+func (g *generator) source(pkg string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by godror-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\nimport (\n", pkg)
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	for _, imp := range imports {
+		fmt.Fprintf(&buf, "\t%q\n", imp)
+	}
+	buf.WriteString("\n\tgodror \"github.com/godror/godror\"\n)\n")
+	buf.Write(g.buf.Bytes())
+	for _, nm := range helperNames {
+		if g.helpers[nm] {
+			buf.WriteString(helpers[nm])
+		}
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	return b, nil
+}
+
+type generator struct {
+	names   map[string]string // full name -> Go name
+	helpers map[string]bool
+	imports map[string]bool
+	types   []*Type
+	buf     bytes.Buffer
+}
+
+// collect the named types in t, dependencies first, assigning Go names.
+func (g *generator) collect(t *Type) {
+	if !t.IsObject() {
+		return
+	}
+	if _, ok := g.names[t.FullName()]; ok {
+		return
+	}
+	g.names[t.FullName()] = "" // guard against loops
+	if t.CollectionOf != nil {
+		g.collect(t.CollectionOf)
+	}
+	for _, a := range t.Attributes {
+		g.collect(a.Type)
+	}
+	nm := goName(t.Package + "_" + t.Name)
+	for taken := true; taken; {
+		taken = false
+		for _, v := range g.names {
+			if v == nm {
+				taken = true
+				nm = goName(t.Schema) + nm
+				break
+			}
+		}
+	}
+	g.names[t.FullName()] = nm
+	g.types = append(g.types, t)
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// goType returns the Go type for t.
+func (g *generator) goType(t *Type) (string, error) {
+	if t.IsObject() {
+		return g.names[t.FullName()], nil
+	}
+	switch t.DBType {
+	case "NUMBER":
+		if t.Scale == 0 && t.Precision > 0 && t.Precision < 19 {
+			if t.Precision < 10 {
+				return "int32", nil
+			}
+			return "int64", nil
+		}
+		// FLOAT(p) is a NUMBER with binary precision p
+		if t.Scale == -127 && t.Precision > 0 && t.Precision <= 53 {
+			return "float64", nil
+		}
+		return "godror.Number", nil
+	case "BINARY_INTEGER":
+		return "int32", nil
+	case "FLOAT":
+		if t.Precision > 53 {
+			return "godror.Number", nil
+		}
+		return "float64", nil
+	case "DOUBLE":
+		return "float64", nil
+	case "BOOLEAN":
+		return "bool", nil
+	case "VARCHAR2", "NVARCHAR2", "CHAR", "NCHAR", "LONG", "CLOB", "NCLOB":
+		return "string", nil
+	case "RAW", "LONG RAW", "BLOB":
+		return "[]byte", nil
+	case "DATE", "TIMESTAMP", "TIMESTAMP WITH TIME ZONE", "TIMESTAMP WITH LOCAL TIME ZONE":
+		g.imports["time"] = true
+		return "time.Time", nil
+	case "INTERVAL DAY TO SECOND":
+		g.imports["time"] = true
+		return "time.Duration", nil
+	case "INTERVAL YEAR TO MONTH":
+		return "godror.IntervalYM", nil
+	}
+	return "", fmt.Errorf("unsupported type %q", t.DBType)
+}
+
+// dbTypeComment returns the type as written in SQL, such as NUMBER(10,2).
+func dbTypeComment(t *Type) string {
+	switch {
+	case t.IsObject():
+		return t.FullName()
+	case t.DBType == "NUMBER" && t.Precision > 0 && t.Scale > 0:
+		return fmt.Sprintf("NUMBER(%d,%d)", t.Precision, t.Scale)
+	case t.DBType == "NUMBER" && t.Precision > 0 && t.Scale == 0:
+		return fmt.Sprintf("NUMBER(%d)", t.Precision)
+	case t.DBType == "NUMBER" && t.Precision > 0 && t.Scale == -127:
+		return fmt.Sprintf("FLOAT(%d)", t.Precision)
+	case t.Length > 0 && (strings.Contains(t.DBType, "CHAR") || t.DBType == "RAW"):
+		return fmt.Sprintf("%s(%d)", t.DBType, t.Length)
+	}
+	return t.DBType
+}
+
+func (g *generator) genObject(t *Type) error {
+	nm := g.names[t.FullName()]
+	fields := make([]string, len(t.Attributes))
+	for i, a := range t.Attributes {
+		fields[i] = goName(a.Name)
+	}
+
+	g.printf("\n// %s represents the %s object type.\n", nm, t.FullName())
+	g.printf("type %s struct {\n\tgodror.ObjectTypeName `godror:%q`\n\n", nm, t.FullName())
+	for i, a := range t.Attributes {
+		typ, err := g.goType(a.Type)
+		if err != nil {
+			return fmt.Errorf("%s: %w", a.Name, err)
+		}
+		tag := a.Name
+		if a.Type.IsObject() {
+			tag += ",type=" + a.Type.FullName()
+		}
+		g.printf("\t%s %s `godror:%q` // %s\n", fields[i], typ, tag, dbTypeComment(a.Type))
+	}
+	g.printf(`
+	// Object is the database object, used when binding as a godror.ObjectWriter
+	// and scanning as a godror.ObjectScanner - see New%[1]s.
+	Object *godror.Object `+"`godror:\"-\"`"+`
+}
+
+var (
+	_ godror.ObjectScanner = (*%[1]s)(nil)
+	_ godror.ObjectWriter  = (*%[1]s)(nil)
+)
+
+// New%[1]s returns a new %[1]s, with the Object set to a new %[2]s.
+//
+// Close it after use!
+func New%[1]s(ctx context.Context, ex godror.Execer) (*%[1]s, error) {
+	ot, err := godror.GetObjectType(ctx, ex, %[2]q)
+	if err != nil {
+		return nil, fmt.Errorf("%%s: %%w", %[2]q, err)
+	}
+	obj, err := ot.NewObject()
+	if err != nil {
+		return nil, fmt.Errorf("%%s: %%w", %[2]q, err)
+	}
+	return &%[1]s{Object: obj}, nil
+}
+
+// ObjectRef returns the database object.
+func (x *%[1]s) ObjectRef() *godror.Object { return x.Object }
+
+// Close the Object and the objects of the attributes.
+func (x *%[1]s) Close() error {
+	var err error
+`, nm, t.FullName())
+	for i, a := range t.Attributes {
+		if a.Type.IsObject() {
+			g.printf("\tif closeErr := x.%s.Close(); closeErr != nil && err == nil {\n\t\terr = closeErr\n\t}\n", fields[i])
+		}
+	}
+	g.printf(`	if x.Object != nil {
+		if closeErr := x.Object.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		x.Object = nil
+	}
+	return err
+}
+
+// Scan the attributes of the *godror.Object into x - a nil src zeroes the fields.
+func (x *%[1]s) Scan(src interface{}) error {
+	obj, _ := src.(*godror.Object)
+	if obj == nil {
+		if src != nil {
+			return fmt.Errorf("%[2]s: cannot scan from %%T", src)
+		}
+		*x = %[1]s{Object: x.Object}
+		return nil
+	}
+`, nm, t.FullName())
+	if len(t.Attributes) != 0 {
+		g.printf("\tvar v interface{}\n\tvar err error\n")
+	}
+	for i, a := range t.Attributes {
+		g.printf("\tif v, err = obj.Get(%q); err != nil {\n\t\treturn fmt.Errorf(\"%s.%s: %%w\", err)\n\t}\n", a.Name, t.Name, a.Name)
+		if a.Type.IsObject() {
+			g.printf("\tif sub, ok := v.(*godror.ObjectCollection); ok {\n\t\tv = sub.Object\n\t}\n")
+			g.printf("\tif sub, _ := v.(*godror.Object); sub != nil {\n")
+			g.printf("\t\terr = x.%s.Scan(sub)\n\t\tsub.Close()\n\t} else {\n\t\terr = x.%[1]s.Scan(nil)\n\t}\n", fields[i])
+		} else {
+			typ, _ := g.goType(a.Type)
+			g.printf("\tx.%s, err = %s(v)\n", fields[i], g.helper(typ))
+		}
+		g.printf("\tif err != nil {\n\t\treturn fmt.Errorf(\"%s.%s: %%w\", err)\n\t}\n", t.Name, a.Name)
+	}
+	g.printf(`	return nil
+}
+
+// WriteObject writes the fields of x into its Object.
+func (x *%[1]s) WriteObject() error {
+	if x.Object == nil {
+		return fmt.Errorf("%[2]s: nil Object")
+	}
+	if err := x.Object.ResetAttributes(); err != nil {
+		return err
+	}
+`, nm, t.FullName())
+	for i, a := range t.Attributes {
+		f := fields[i]
+		cond := ""
+		switch typ, _ := g.goType(a.Type); {
+		case a.Type.IsObject():
+			g.printf(`	if x.%[1]s.Object == nil {
+		ot := x.Object.Attributes[%[2]q].ObjectType
+		var err error
+		if x.%[1]s.Object, err = ot.NewObject(); err != nil {
+			return fmt.Errorf("%[3]s.%[2]s: %%w", err)
+		}
+	}
+	if err := x.%[1]s.WriteObject(); err != nil {
+		return fmt.Errorf("%[3]s.%[2]s: %%w", err)
+	}
+	if err := x.Object.Set(%[2]q, x.%[1]s.Object); err != nil {
+		return fmt.Errorf("%[3]s.%[2]s: %%w", err)
+	}
+`, f, a.Name, t.Name)
+			continue
+		case typ == "godror.Number":
+			// an empty Number is NULL
+			cond = "x." + f + ` != ""`
+			g.printf("\tif %s {\n\t\tif err := x.Object.Set(%q, string(x.%s)); err != nil {\n", cond, a.Name, f)
+		case typ == "[]byte":
+			cond = "x." + f + " != nil"
+		case typ == "time.Time":
+			cond = "!x." + f + ".IsZero()"
+		}
+		if cond == "" {
+			g.printf("\tif err := x.Object.Set(%q, x.%s); err != nil {\n", a.Name, f)
+			g.printf("\t\treturn fmt.Errorf(\"%s.%s: %%w\", err)\n\t}\n", t.Name, a.Name)
+			continue
+		}
+		if !strings.HasSuffix(cond, `""`) {
+			g.printf("\tif %s {\n\t\tif err := x.Object.Set(%q, x.%s); err != nil {\n", cond, a.Name, f)
+		}
+		g.printf("\t\t\treturn fmt.Errorf(\"%s.%s: %%w\", err)\n\t\t}\n\t}\n", t.Name, a.Name)
+	}
+	g.printf("\treturn nil\n}\n")
+	return nil
+}
+
+func (g *generator) genCollection(t *Type) error {
+	nm := g.names[t.FullName()]
+	elt := t.CollectionOf
+	typ, err := g.goType(elt)
+	if err != nil {
+		return err
+	}
+	g.printf("\n// %s represents the %s collection type (of %s).\n", nm, t.FullName(), dbTypeComment(elt))
+	g.printf("type %s struct {\n\tgodror.ObjectTypeName `godror:%q`\n\n", nm, t.FullName())
+	g.printf("\tItems []%s `godror:\",type=%s\"`\n", typ, t.FullName())
+	g.printf(`
+	// Object is the database collection, used when binding as a godror.ObjectWriter
+	// and scanning as a godror.ObjectScanner - see New%[1]s.
+	Object *godror.Object `+"`godror:\"-\"`"+`
+}
+
+var (
+	_ godror.ObjectScanner = (*%[1]s)(nil)
+	_ godror.ObjectWriter  = (*%[1]s)(nil)
+)
+
+// New%[1]s returns a new, empty %[1]s, with the Object set to a new %[2]s.
+//
+// Close it after use!
+func New%[1]s(ctx context.Context, ex godror.Execer) (*%[1]s, error) {
+	ot, err := godror.GetObjectType(ctx, ex, %[2]q)
+	if err != nil {
+		return nil, fmt.Errorf("%%s: %%w", %[2]q, err)
+	}
+	coll, err := ot.NewCollection()
+	if err != nil {
+		return nil, fmt.Errorf("%%s: %%w", %[2]q, err)
+	}
+	return &%[1]s{Object: coll.Object}, nil
+}
+
+// ObjectRef returns the database collection.
+func (x *%[1]s) ObjectRef() *godror.Object { return x.Object }
+
+// Close the Object and the objects of the items.
+func (x *%[1]s) Close() error {
+	var err error
+`, nm, t.FullName())
+	if elt.IsObject() {
+		g.printf("\tfor i := range x.Items {\n\t\tif closeErr := x.Items[i].Close(); closeErr != nil && err == nil {\n\t\t\terr = closeErr\n\t\t}\n\t}\n")
+	}
+	g.printf(`	if x.Object != nil {
+		if closeErr := x.Object.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		x.Object = nil
+	}
+	return err
+}
+
+// Scan the elements of the *godror.Object collection into x.Items.
+func (x *%[1]s) Scan(src interface{}) error {
+	x.Items = x.Items[:0]
+	obj, ok := src.(*godror.Object)
+	if !ok {
+		if src == nil {
+			return nil
+		}
+		return fmt.Errorf("%[2]s: cannot scan from %%T", src)
+	}
+	if obj == nil {
+		return nil
+	}
+	coll := obj.Collection()
+	i, err := coll.First()
+	for ; err == nil; i, err = coll.Next(i) {
+		v, getErr := coll.Get(i)
+		if getErr != nil {
+			return fmt.Errorf("%[3]s[%%d]: %%w", i, getErr)
+		}
+		var item %[4]s
+		var convErr error
+`, nm, t.FullName(), t.Name, typ)
+	if elt.IsObject() {
+		g.printf("\t\tif sub, _ := v.(*godror.Object); sub != nil {\n\t\t\tconvErr = item.Scan(sub)\n\t\t\tsub.Close()\n\t\t}\n")
+	} else {
+		g.printf("\t\titem, convErr = %s(v)\n", g.helper(typ))
+	}
+	g.imports["errors"] = true
+	g.printf(`		if convErr != nil {
+			return fmt.Errorf("%[1]s[%%d]: %%w", i, convErr)
+		}
+		x.Items = append(x.Items, item)
+	}
+	if err != nil && !errors.Is(err, godror.ErrNotExist) {
+		return fmt.Errorf("%[1]s: %%w", err)
+	}
+	return nil
+}
+
+// WriteObject replaces the elements of the Object with x.Items.
+func (x *%[2]s) WriteObject() error {
+	if x.Object == nil {
+		return fmt.Errorf("%[3]s: nil Object")
+	}
+	coll := x.Object.Collection()
+	if n, err := coll.Len(); err != nil {
+		return fmt.Errorf("%[1]s: %%w", err)
+	} else if n != 0 {
+		if err = coll.Trim(n); err != nil {
+			return fmt.Errorf("%[1]s: %%w", err)
+		}
+	}
+	for i := range x.Items {
+`, t.Name, nm, t.FullName())
+	if elt.IsObject() {
+		g.printf(`		item := &x.Items[i]
+		if item.Object == nil {
+			var err error
+			if item.Object, err = x.Object.CollectionOf.NewObject(); err != nil {
+				return fmt.Errorf("%[1]s[%%d]: %%w", i, err)
+			}
+		}
+		if err := item.WriteObject(); err != nil {
+			return fmt.Errorf("%[1]s[%%d]: %%w", i, err)
+		}
+		if err := coll.AppendObject(item.Object); err != nil {
+			return fmt.Errorf("%[1]s[%%d]: %%w", i, err)
+		}
+`, t.Name)
+	} else {
+		v := "x.Items[i]"
+		if typ == "godror.Number" {
+			v = "string(x.Items[i])"
+		}
+		g.printf("\t\tif err := coll.Append(%s); err != nil {\n\t\t\treturn fmt.Errorf(\"%s[%%d]: %%w\", i, err)\n\t\t}\n", v, t.Name)
+	}
+	g.printf("\t}\n\treturn nil\n}\n")
+	return nil
+}
+
+// helper returns the name of the conversion function for the Go type, marking it as used.
+func (g *generator) helper(typ string) string {
+	nm := helperOf[typ]
+	if nm == "" {
+		panic("no helper for " + typ)
+	}
+	for _, dep := range append([]string{nm}, helperDeps[nm]...) {
+		g.helpers[dep] = true
+		for _, imp := range helperImports[dep] {
+			g.imports[imp] = true
+		}
+	}
+	return nm
+}
+
+var helperOf = map[string]string{
+	"int32": "asInt32", "int64": "asInt64", "float64": "asFloat64",
+	"godror.Number": "asNumber", "bool": "asBool", "string": "asString", "[]byte": "asBytes",
+	"time.Time": "asTime", "time.Duration": "asDuration", "godror.IntervalYM": "asIntervalYM",
+}
+
+var helperDeps = map[string][]string{
+	"asInt32": {"asInt64"},
+}
+
+var helperImports = map[string][]string{
+	"asInt32":   {"strconv"},
+	"asInt64":   {"strconv"},
+	"asFloat64": {"strconv"},
+	"asString":  {"io"},
+	"asBytes":   {"bytes", "io"},
+	"asNumber":  {"strconv"},
+}
+
+var helperNames = func() []string {
+	names := make([]string, 0, len(helpers))
+	for k := range helpers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// helpers convert the values returned by godror.Object.Get and godror.ObjectCollection.Get.
+var helpers = map[string]string{
+	"asInt32": `
+func asInt32(v interface{}) (int32, error) {
+	i, err := asInt64(v)
+	return int32(i), err
+}
+`,
+	"asInt64": `
+func asInt64(v interface{}) (int64, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return x, nil
+	case uint64:
+		return int64(x), nil
+	case float64:
+		return int64(x), nil
+	case float32:
+		return int64(x), nil
+	case string:
+		return strconv.ParseInt(x, 10, 64)
+	case []byte:
+		return strconv.ParseInt(string(x), 10, 64)
+	}
+	return 0, fmt.Errorf("cannot convert %T to int64", v)
+}
+`,
+	"asFloat64": `
+func asFloat64(v interface{}) (float64, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return x, nil
+	case float32:
+		return float64(x), nil
+	case int64:
+		return float64(x), nil
+	case uint64:
+		return float64(x), nil
+	case string:
+		return strconv.ParseFloat(x, 64)
+	case []byte:
+		return strconv.ParseFloat(string(x), 64)
+	}
+	return 0, fmt.Errorf("cannot convert %T to float64", v)
+}
+`,
+	"asNumber": `
+func asNumber(v interface{}) (godror.Number, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return godror.Number(x), nil
+	case []byte:
+		return godror.Number(x), nil
+	case int64:
+		return godror.Number(strconv.FormatInt(x, 10)), nil
+	case uint64:
+		return godror.Number(strconv.FormatUint(x, 10)), nil
+	case float64:
+		return godror.Number(strconv.FormatFloat(x, 'f', -1, 64)), nil
+	case float32:
+		return godror.Number(strconv.FormatFloat(float64(x), 'f', -1, 32)), nil
+	}
+	return "", fmt.Errorf("cannot convert %T to Number", v)
+}
+`,
+	"asBool": `
+func asBool(v interface{}) (bool, error) {
+	if v == nil {
+		return false, nil
+	}
+	if b, ok := v.(bool); ok {
+		return b, nil
+	}
+	return false, fmt.Errorf("cannot convert %T to bool", v)
+}
+`,
+	"asString": `
+func asString(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	case []byte:
+		return string(x), nil
+	case *godror.Lob:
+		b, err := io.ReadAll(x)
+		return string(b), err
+	}
+	return "", fmt.Errorf("cannot convert %T to string", v)
+}
+`,
+	"asBytes": `
+func asBytes(v interface{}) ([]byte, error) {
+	switch x := v.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		// do not keep a reference to the memory of the Oracle client
+		return bytes.Clone(x), nil
+	case string:
+		return []byte(x), nil
+	case *godror.Lob:
+		return io.ReadAll(x)
+	}
+	return nil, fmt.Errorf("cannot convert %T to []byte", v)
+}
+`,
+	"asTime": `
+func asTime(v interface{}) (time.Time, error) {
+	if v == nil {
+		return time.Time{}, nil
+	}
+	if t, ok := v.(time.Time); ok {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("cannot convert %T to time.Time", v)
+}
+`,
+	"asDuration": `
+func asDuration(v interface{}) (time.Duration, error) {
+	if v == nil {
+		return 0, nil
+	}
+	if d, ok := v.(time.Duration); ok {
+		return d, nil
+	}
+	return 0, fmt.Errorf("cannot convert %T to time.Duration", v)
+}
+`,
+	"asIntervalYM": `
+func asIntervalYM(v interface{}) (godror.IntervalYM, error) {
+	if v == nil {
+		return godror.IntervalYM{}, nil
+	}
+	if d, ok := v.(godror.IntervalYM); ok {
+		return d, nil
+	}
+	return godror.IntervalYM{}, fmt.Errorf("cannot convert %T to IntervalYM", v)
+}
+`,
+}
+
+// goName converts an Oracle name (such as EMP_ADDRESS_OT) to an exported Go name (EmpAddressOt),
+// keeping the common initialisms (ID, URL, ...) uppercase.
+func goName(s string) string {
+	var buf strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	}) {
+		if upper := strings.ToUpper(part); initialisms[upper] {
+			buf.WriteString(upper)
+			continue
+		}
+		for i, r := range strings.ToLower(part) {
+			if i == 0 {
+				r = unicode.ToUpper(r)
+			}
+			buf.WriteRune(r)
+		}
+	}
+	if buf.Len() == 0 {
+		return "X"
+	}
+	nm := buf.String()
+	if unicode.IsDigit(rune(nm[0])) {
+		nm = "X" + nm
+	}
+	return nm
+}
+
+var initialisms = map[string]bool{
+	"ID": true, "URL": true, "URI": true, "UUID": true, "XML": true, "JSON": true,
+	"HTML": true, "HTTP": true, "SQL": true, "API": true, "IP": true, "OK": true,
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var flagUpdate = flag.Bool("update", false, "update the golden files")
+
+func TestGenerateGolden(t *testing.T) {
+	testGolden(t, filepath.Join("testdata", "*.json"), func(b []byte) ([]byte, error) {
+		var types []*Type
+		if err := json.Unmarshal(b, &types); err != nil {
+			return nil, err
+		}
+		return Generate("hr", types)
+	})
+}
+
+func TestGeneratePlsqlGolden(t *testing.T) {
+	testGolden(t, filepath.Join("testdata", "plsql", "*.json"), func(b []byte) ([]byte, error) {
+		var pkgs []*PlsqlPackage
+		if err := json.Unmarshal(b, &pkgs); err != nil {
+			return nil, err
+		}
+		return GeneratePlsql("hr", pkgs, 100)
+	})
+}
+
+// testGolden compares the code generated from each file matching pattern with the .go.golden file next to it.
+func testGolden(t *testing.T, pattern string, generate func([]byte) ([]byte, error)) {
+	t.Helper()
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no test data")
+	}
+	for _, fn := range files {
+		fn := fn
+		t.Run(filepath.Base(fn), func(t *testing.T) {
+			b, err := os.ReadFile(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := generate(b)
+			if err != nil {
+				t.Fatalf("%+v\n%s", err, got)
+			}
+			goldenFn := strings.TrimSuffix(fn, ".json") + ".go.golden"
+			if *flagUpdate {
+				if err = os.WriteFile(goldenFn, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(goldenFn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s differs from the generated code (run with -update to overwrite):\n%s", goldenFn, got)
+			}
+		})
+	}
+}
+
+func TestScalarType(t *testing.T) {
+	str := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+	num := func(i int64) sql.NullInt64 { return sql.NullInt64{Int64: i, Valid: true} }
+	for _, tC := range []struct {
+		Row  argRow
+		Want string
+	}{
+		{argRow{DataType: str("NUMBER")}, "godror.Number"},
+		{argRow{DataType: str("NUMBER"), Precision: num(5), Scale: num(0)}, "int32"},
+		{argRow{DataType: str("NUMBER"), PlsType: str("PLS_INTEGER")}, "int32"},
+		{argRow{DataType: str("PL/SQL PLS INTEGER")}, "int32"},
+		{argRow{DataType: str("FLOAT"), Precision: num(126)}, "godror.Number"},
+		{argRow{DataType: str("BINARY_DOUBLE")}, "float64"},
+		{argRow{DataType: str("PL/SQL BOOLEAN")}, "bool"},
+		{argRow{DataType: str("TIMESTAMP WITH TZ")}, "time.Time"},
+		{argRow{DataType: str("VARCHAR2")}, "string"},
+	} {
+		typ := scalarType(tC.Row)
+		if got, err := newGenerator().goType(&typ); err != nil {
+			t.Errorf("%+v: %+v", tC.Row, err)
+		} else if got != tC.Want {
+			t.Errorf("%+v: got %s, wanted %s", tC.Row, got, tC.Want)
+		}
+	}
+}
+
+func TestGoType(t *testing.T) {
+	g := newGenerator()
+	for _, tC := range []struct {
+		Type Type
+		Want string
+	}{
+		{Type{DBType: "NUMBER", Precision: 1}, "int32"},
+		{Type{DBType: "NUMBER", Precision: 9}, "int32"},
+		{Type{DBType: "NUMBER", Precision: 10}, "int64"},
+		{Type{DBType: "NUMBER", Precision: 18}, "int64"},
+		{Type{DBType: "NUMBER", Precision: 19}, "godror.Number"},
+		{Type{DBType: "NUMBER", Precision: 14, Scale: 2}, "godror.Number"},
+		{Type{DBType: "NUMBER", Precision: 15, Scale: 2}, "godror.Number"},
+		{Type{DBType: "NUMBER", Scale: -127}, "godror.Number"},
+		{Type{DBType: "NUMBER", Precision: 53, Scale: -127}, "float64"},
+		{Type{DBType: "NUMBER", Precision: 126, Scale: -127}, "godror.Number"},
+		{Type{DBType: "BINARY_INTEGER"}, "int32"},
+		{Type{DBType: "FLOAT"}, "float64"},
+		{Type{DBType: "FLOAT", Precision: 126}, "godror.Number"},
+		{Type{DBType: "NVARCHAR2", Length: 10}, "string"},
+		{Type{DBType: "BLOB"}, "[]byte"},
+		{Type{DBType: "TIMESTAMP WITH LOCAL TIME ZONE"}, "time.Time"},
+		{Type{DBType: "INTERVAL YEAR TO MONTH"}, "godror.IntervalYM"},
+	} {
+		if got, err := g.goType(&tC.Type); err != nil {
+			t.Errorf("%+v: %+v", tC.Type, err)
+		} else if got != tC.Want {
+			t.Errorf("%+v: got %s, wanted %s", tC.Type, got, tC.Want)
+		}
+	}
+	if got, err := g.goType(&Type{DBType: "XMLTYPE"}); err == nil {
+		t.Errorf("XMLTYPE: got %s", got)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for in, want := range map[string]string{
+		"EMP_OT":          "EmpOt",
+		"EMP_ID":          "EmpID",
+		"_REC_TYPE":       "RecType",
+		"PKG_A$B#C":       "PkgABC",
+		"1ST":             "X1st",
+		"JSON_URL_LIST":   "JSONURLList",
+		"\"MixedCase\"":   "Mixedcase",
+		"ÁRVÍZTŰRŐ_TÜKÖR": "ÁrvíztűrőTükör",
+	} {
+		if got := goName(in); got != want {
+			t.Errorf("%q: got %q, wanted %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+// Command godror-gen generates Go struct types (with godror struct tags,
+// and godror.ObjectScanner / godror.ObjectWriter implementations)
+// for Oracle object and collection types, including the types they reference.
+//
+// Usage:
+//
+//	godror-gen -connect 'user/passw@db' -pkg mypkg -o types_gen.go HR.EMP_OT HR.EMP_TAB
+//
+// With -plsql the arguments are PL/SQL package names, and it generates a function for each
+// procedure and function of the packages, with input and output structs, binding the
+// records and collections as the generated types, the index-by tables of scalars as slices
+// (with godror.PlSQLArrays) and the REF CURSORs as driver.Rows:
+//
+//	godror-gen -connect 'user/passw@db' -pkg mypkg -o emp_pkg_gen.go -plsql HR.EMP_PKG
+//
+// With -json it prints the description of the types instead,
+// which can be fed back with -from to generate the code without a database connection.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	godror "github.com/godror/godror"
+)
+
+func main() {
+	if err := Main(); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
+
+func Main() error {
+	flagConnect := flag.String("connect", os.Getenv("GODROR_TEST_DSN"), "database connection string")
+	flagPkg := flag.String("pkg", "main", "package name of the generated code")
+	flagOut := flag.String("o", "", "output file name (default: stdout)")
+	flagJSON := flag.Bool("json", false, "print the description of the types as JSON, instead of the Go code")
+	flagFrom := flag.String("from", "", "read the description of the types from this JSON file, instead of the database")
+	flagPlsql := flag.Bool("plsql", false, "generate calls for the procedures of the named PL/SQL packages")
+	flagArraySize := flag.Int("array-size", 1024, "maximum number of elements of the OUT index-by tables (with -plsql)")
+	flagTimeout := flag.Duration("timeout", time.Minute, "timeout")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] TYPE_NAME...\n       %[1]s [flags] -plsql PACKAGE_NAME...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *flagTimeout)
+	defer cancel()
+
+	var types []*Type
+	var pkgs []*PlsqlPackage
+	if *flagFrom != "" {
+		b, err := os.ReadFile(*flagFrom)
+		if err != nil {
+			return err
+		}
+		if *flagPlsql {
+			err = json.Unmarshal(b, &pkgs)
+		} else {
+			err = json.Unmarshal(b, &types)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", *flagFrom, err)
+		}
+	} else {
+		if flag.NArg() == 0 {
+			flag.Usage()
+			return fmt.Errorf("no names given")
+		}
+		var err error
+		if types, pkgs, err = describe(ctx, *flagConnect, flag.Args(), *flagPlsql); err != nil {
+			return err
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if *flagOut != "" {
+		fh, err := os.Create(*flagOut)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		w = fh
+	}
+	if *flagJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if *flagPlsql {
+			return enc.Encode(pkgs)
+		}
+		return enc.Encode(types)
+	}
+	var b []byte
+	var err error
+	if *flagPlsql {
+		b, err = GeneratePlsql(*flagPkg, pkgs, *flagArraySize)
+	} else {
+		b, err = Generate(*flagPkg, types)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(b); err != nil {
+		return err
+	}
+	if fh, ok := w.(*os.File); ok && fh != os.Stdout {
+		return fh.Close()
+	}
+	return nil
+}
+
+// describe connects to the database and returns the descriptions of the named types,
+// or the named packages if plsql is true.
+func describe(ctx context.Context, dsn string, names []string, plsql bool) ([]*Type, []*PlsqlPackage, error) {
+	db, err := sql.Open("godror", dsn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", dsn, err)
+	}
+	defer db.Close()
+	// The object types belong to the connection.
+	cx, err := db.Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect: %w", err)
+	}
+	defer cx.Close()
+	if plsql {
+		pkgs := make([]*PlsqlPackage, 0, len(names))
+		for _, nm := range names {
+			pkg, err := DescribePackage(ctx, cx, nm)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", nm, err)
+			}
+			pkgs = append(pkgs, pkg)
+		}
+		return nil, pkgs, nil
+	}
+	types := make([]*Type, 0, len(names))
+	for _, nm := range names {
+		d, err := godror.DescribeObjectType(ctx, cx, nm)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", nm, err)
+		}
+		types = append(types, fromDescription(d))
+	}
+	return types, nil, nil
+}
@@ -0,0 +1,392 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	godror "github.com/godror/godror"
+)
+
+// PlsqlPackage is the serializable description of a PL/SQL package's procedures and functions.
+type PlsqlPackage struct {
+	Owner string
+	Name  string
+	Procs []Proc
+}
+
+// Proc is a procedure or function of a package.
+type Proc struct {
+	Return   *Type `json:",omitempty"` // nil for procedures
+	Name     string
+	Args     []Arg `json:",omitempty"`
+	Overload int   `json:",omitempty"`
+}
+
+// Arg is an argument of a procedure or function.
+type Arg struct {
+	Type      *Type
+	Name      string
+	Direction string // IN, OUT or IN/OUT
+}
+
+// Direction values, as ALL_ARGUMENTS.IN_OUT has them.
+const (
+	dirIn    = "IN"
+	dirOut   = "OUT"
+	dirInOut = "IN/OUT"
+)
+
+// The DBType of index-by tables of scalars (bound as slices with godror.PlSQLArrays) and REF CURSORs.
+const (
+	dbTypePlsqlTable = "PL/SQL TABLE"
+	dbTypeRefCursor  = "REF CURSOR"
+)
+
+// FullName returns the name of the procedure, as it can be called.
+func (p PlsqlPackage) FullName() string {
+	if p.Owner == "" {
+		return p.Name
+	}
+	return p.Owner + "." + p.Name
+}
+
+// DescribePackage reads the procedures and functions of the package (OWNER.NAME or NAME)
+// from ALL_ARGUMENTS, describing the record, object and collection types with godror.DescribeObjectType.
+// The object types belong to the connection, so this needs an *sql.Conn.
+func DescribePackage(ctx context.Context, ex *sql.Conn, name string) (*PlsqlPackage, error) {
+	pkg := PlsqlPackage{Name: strings.ToUpper(name)}
+	if i := strings.IndexByte(pkg.Name, '.'); i >= 0 {
+		pkg.Owner, pkg.Name = pkg.Name[:i], pkg.Name[i+1:]
+	} else if err := ex.QueryRowContext(ctx, "SELECT USER FROM DUAL").Scan(&pkg.Owner); err != nil {
+		return nil, err
+	}
+
+	const qry = `SELECT A.object_name, NVL(A.overload, '0'), A.argument_name, A.position, A.data_level,
+       A.data_type, A.pls_type, A.in_out, A.data_precision, A.data_scale, A.char_length,
+       A.type_owner, A.type_name, A.type_subname
+  FROM all_arguments A
+  WHERE A.owner = :1 AND A.package_name = :2
+  ORDER BY A.object_name, TO_NUMBER(NVL(A.overload, '0')), A.sequence`
+	rows, err := ex.QueryContext(ctx, qry, pkg.Owner, pkg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", qry, err)
+	}
+	defer rows.Close()
+	var all []argRow
+	for rows.Next() {
+		var r argRow
+		if err = rows.Scan(&r.ObjectName, &r.Overload, &r.ArgumentName, &r.Position, &r.DataLevel,
+			&r.DataType, &r.PlsType, &r.InOut, &r.Precision, &r.Scale, &r.CharLength,
+			&r.TypeOwner, &r.TypeName, &r.TypeSubname,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", qry, err)
+		}
+		all = append(all, r)
+	}
+	if err = rows.Close(); err != nil {
+		return nil, fmt.Errorf("%s: %w", qry, err)
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("%s: %w", pkg.FullName(), sql.ErrNoRows)
+	}
+
+	objTypes := make(map[string]*Type)
+	describe := func(r argRow, elt *argRow) (*Type, error) {
+		dt := r.DataType.String
+		switch dt {
+		case "PL/SQL RECORD", "OBJECT", "TABLE", "VARRAY", "PL/SQL TABLE", "PL/SQL INDEX TABLE", "ASSOCIATIVE ARRAY":
+			if (dt == "PL/SQL TABLE" || dt == "PL/SQL INDEX TABLE" || dt == "ASSOCIATIVE ARRAY") &&
+				elt != nil && !isComposite(elt.DataType.String) {
+				sub := scalarType(*elt)
+				return &Type{DBType: dbTypePlsqlTable, CollectionOf: &sub}, nil
+			}
+			name := r.TypeOwner.String + "." + r.TypeName.String
+			if r.TypeSubname.String != "" {
+				name += "." + r.TypeSubname.String
+			}
+			if t := objTypes[name]; t != nil {
+				return t, nil
+			}
+			d, err := godror.DescribeObjectType(ctx, ex, name)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			t := fromDescription(d)
+			objTypes[name] = t
+			return t, nil
+		case "REF CURSOR":
+			return &Type{DBType: dbTypeRefCursor}, nil
+		}
+		t := scalarType(r)
+		return &t, nil
+	}
+
+	for i := 0; i < len(all); i++ {
+		r := all[i]
+		if len(pkg.Procs) == 0 || pkg.Procs[len(pkg.Procs)-1].Name != r.ObjectName ||
+			strconv.Itoa(pkg.Procs[len(pkg.Procs)-1].Overload) != r.Overload {
+			overload, _ := strconv.Atoi(r.Overload)
+			pkg.Procs = append(pkg.Procs, Proc{Name: r.ObjectName, Overload: overload})
+		}
+		proc := &pkg.Procs[len(pkg.Procs)-1]
+		if r.DataLevel.Int64 != 0 || !r.DataType.Valid {
+			// the fields of a record or the element of a table, or the placeholder of a procedure without arguments
+			continue
+		}
+		var elt *argRow
+		if i+1 < len(all) && all[i+1].DataLevel.Int64 == 1 && all[i+1].ObjectName == r.ObjectName {
+			elt = &all[i+1]
+		}
+		t, err := describe(r, elt)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s.%s: %w", pkg.FullName(), r.ObjectName, r.ArgumentName.String, err)
+		}
+		if r.Position.Int64 == 0 && !r.ArgumentName.Valid {
+			proc.Return = t
+			continue
+		}
+		proc.Args = append(proc.Args, Arg{Name: r.ArgumentName.String, Direction: r.InOut.String, Type: t})
+	}
+	return &pkg, nil
+}
+
+// argRow is a row of ALL_ARGUMENTS.
+type argRow struct {
+	ObjectName, Overload                              string
+	ArgumentName, DataType, PlsType, InOut            sql.NullString
+	TypeOwner, TypeName, TypeSubname                  sql.NullString
+	Position, DataLevel, Precision, Scale, CharLength sql.NullInt64
+}
+
+// isComposite reports whether the ALL_ARGUMENTS.DATA_TYPE is a record, object or collection.
+func isComposite(dataType string) bool {
+	switch dataType {
+	case "PL/SQL RECORD", "OBJECT", "TABLE", "VARRAY", "PL/SQL TABLE", "PL/SQL INDEX TABLE", "ASSOCIATIVE ARRAY":
+		return true
+	}
+	return false
+}
+
+// scalarType converts the ALL_ARGUMENTS.DATA_TYPE names to the names used by ObjectType.DatabaseTypeName.
+func scalarType(r argRow) Type {
+	t := Type{DBType: r.DataType.String}
+	switch t.DBType {
+	case "NUMBER":
+		t.Precision, t.Scale = int16(r.Precision.Int64), int8(r.Scale.Int64)
+		if !r.Precision.Valid {
+			t.Scale = -127
+		}
+	case "FLOAT":
+		t.DBType, t.Precision, t.Scale = "NUMBER", int16(r.Precision.Int64), -127
+	case "BINARY_FLOAT":
+		t.DBType = "FLOAT"
+	case "BINARY_DOUBLE":
+		t.DBType = "DOUBLE"
+	case "PL/SQL BOOLEAN":
+		t.DBType = "BOOLEAN"
+	case "PL/SQL PLS INTEGER", "PL/SQL BINARY INTEGER", "PLS_INTEGER":
+		t.DBType = "BINARY_INTEGER"
+	case "TIMESTAMP WITH LOCAL TZ":
+		t.DBType = "TIMESTAMP WITH LOCAL TIME ZONE"
+	case "TIMESTAMP WITH TZ":
+		t.DBType = "TIMESTAMP WITH TIME ZONE"
+	case "INTERVAL DAY TO SECOND", "INTERVAL YEAR TO MONTH", "DATE", "TIMESTAMP", "TIMESTAMP WITH TIME ZONE":
+	default:
+		if strings.Contains(t.DBType, "CHAR") || t.DBType == "RAW" {
+			t.Length = int(r.CharLength.Int64)
+		}
+	}
+	if r.PlsType.String == "PLS_INTEGER" || r.PlsType.String == "BINARY_INTEGER" {
+		t.DBType, t.Precision, t.Scale = "BINARY_INTEGER", 0, 0
+	}
+	return t
+}
+
+// genProc generates the input and output structs and the calling function of the procedure.
+func (g *generator) genProc(pkg PlsqlPackage, proc Proc, arraySize int) error {
+	fullName := pkg.FullName() + "." + proc.Name
+	nm := goName(pkg.Name + "_" + proc.Name)
+	if proc.Overload > 1 {
+		nm += strconv.Itoa(proc.Overload)
+	}
+	fields := make([]string, len(proc.Args))
+	var ins, outs []int
+	var hasArrays, hasCursors bool
+	for i, a := range proc.Args {
+		fields[i] = goName(a.Name)
+		if a.Direction != dirOut {
+			ins = append(ins, i)
+		}
+		if a.Direction != dirIn {
+			outs = append(outs, i)
+		}
+		switch a.Type.DBType {
+		case dbTypePlsqlTable:
+			hasArrays = true
+		case dbTypeRefCursor:
+			hasCursors = true
+		}
+	}
+	if proc.Return != nil && proc.Return.DBType == dbTypeRefCursor {
+		hasCursors = true
+	}
+	if hasCursors {
+		g.imports["database/sql/driver"] = true
+	}
+
+	argType := func(t *Type) (string, error) {
+		switch t.DBType {
+		case dbTypePlsqlTable:
+			typ, err := g.goType(t.CollectionOf)
+			return "[]" + typ, err
+		case dbTypeRefCursor:
+			return "driver.Rows", nil
+		}
+		return g.goType(t)
+	}
+	comment := func(t *Type) string {
+		switch t.DBType {
+		case dbTypePlsqlTable:
+			return "index-by table of " + dbTypeComment(t.CollectionOf)
+		case dbTypeRefCursor:
+			return "REF CURSOR, use godror.WrapRows to get an *sql.Rows"
+		}
+		return dbTypeComment(t)
+	}
+	printStruct := func(kind string, idx []int, ret bool) error {
+		g.printf("\n// %s%s is the %s of %s.\ntype %[1]s%[2]s struct {\n", nm, kind, strings.ToLower(kind), fullName)
+		if ret {
+			typ, err := argType(proc.Return)
+			if err != nil {
+				return fmt.Errorf("return: %w", err)
+			}
+			g.printf("\tRet %s // return value: %s\n", typ, comment(proc.Return))
+		}
+		for _, i := range idx {
+			a := proc.Args[i]
+			typ, err := argType(a.Type)
+			if err != nil {
+				return fmt.Errorf("%s: %w", a.Name, err)
+			}
+			g.printf("\t%s %s // %s %s\n", fields[i], typ, a.Name, comment(a.Type))
+		}
+		g.printf("}\n")
+		return nil
+	}
+	if len(ins) != 0 {
+		if err := printStruct("Input", ins, false); err != nil {
+			return err
+		}
+	}
+	hasOut := len(outs) != 0 || proc.Return != nil
+	if hasOut {
+		if err := printStruct("Output", outs, proc.Return != nil); err != nil {
+			return err
+		}
+	}
+
+	// the anonymous PL/SQL block
+	var qry strings.Builder
+	qry.WriteString("BEGIN ")
+	n := 0
+	if proc.Return != nil {
+		n++
+		qry.WriteString(":1 := ")
+	}
+	qry.WriteString(fullName)
+	if len(proc.Args) != 0 {
+		qry.WriteByte('(')
+		for i, a := range proc.Args {
+			if i != 0 {
+				qry.WriteString(", ")
+			}
+			n++
+			fmt.Fprintf(&qry, "%s=>:%d", a.Name, n)
+		}
+		qry.WriteByte(')')
+	}
+	qry.WriteString("; END;")
+
+	g.printf("\n// %s calls %s.\n", nm, fullName)
+	if hasCursors {
+		g.printf("//\n// The returned driver.Rows must be closed.\n")
+	}
+	params := "ctx context.Context, ex godror.Execer"
+	if len(ins) != 0 {
+		params += ", in " + nm + "Input"
+	}
+	if hasOut {
+		g.printf("func %s(%s) (%[1]sOutput, error) {\n\tvar out %[1]sOutput\n", nm, params)
+	} else {
+		g.printf("func %s(%s) error {\n", nm, params)
+	}
+	ret := "err"
+	if hasOut {
+		ret = "out, err"
+	}
+	g.printf("\tconst qry = %q\n", qry.String())
+	if n == 0 {
+		g.printf("\tif _, err := ex.ExecContext(ctx, qry); err != nil {\n\t\treturn fmt.Errorf(\"%%s: %%w\", qry, err)\n\t}\n\treturn nil\n}\n")
+		return nil
+	}
+	g.printf("\targs := make([]interface{}, 0, %d)\n", n+1)
+	if hasArrays {
+		g.printf("\targs = append(args, godror.PlSQLArrays)\n")
+	}
+	bindOut := func(field, dir string) {
+		if dir == dirInOut {
+			g.printf("\targs = append(args, sql.Out{Dest: &out.%s, In: true})\n", field)
+		} else {
+			g.printf("\targs = append(args, sql.Out{Dest: &out.%s})\n", field)
+		}
+	}
+	bind := func(t *Type, field, dir string) {
+		switch {
+		case dir == dirIn && t.IsObject():
+			g.printf("\tif in.%[1]s.Object == nil {\n\t\tx, err := New%[2]s(ctx, ex)\n", field, g.names[t.FullName()])
+			g.printf("\t\tif err != nil {\n\t\t\treturn %s\n\t\t}\n\t\tdefer x.Close()\n\t\tin.%s.Object = x.Object\n\t}\n", ret, field)
+			g.printf("\targs = append(args, &in.%s)\n", field)
+		case dir == dirIn:
+			g.printf("\targs = append(args, in.%s)\n", field)
+		case t.IsObject():
+			if dir == dirInOut {
+				g.printf("\tout.%[1]s = in.%[1]s\n", field)
+			}
+			g.printf("\tif out.%[1]s.Object == nil {\n\t\tx, err := New%[2]s(ctx, ex)\n", field, g.names[t.FullName()])
+			g.printf("\t\tif err != nil {\n\t\t\treturn %s\n\t\t}\n\t\tout.%s.Object = x.Object\n\t}\n", ret, field)
+			bindOut(field, dir)
+		case t.DBType == dbTypePlsqlTable:
+			typ, _ := argType(t)
+			if dir == dirInOut {
+				g.printf("\tout.%[1]s = append(make(%[2]s, 0, max(len(in.%[1]s), %[3]d)), in.%[1]s...)\n", field, typ, arraySize)
+			} else {
+				g.printf("\tout.%s = make(%s, 0, %d)\n", field, typ, arraySize)
+			}
+			bindOut(field, dir)
+		default:
+			if dir == dirInOut {
+				g.printf("\tout.%[1]s = in.%[1]s\n", field)
+			}
+			bindOut(field, dir)
+		}
+	}
+	if proc.Return != nil {
+		bind(proc.Return, "Ret", dirOut)
+	}
+	for i, a := range proc.Args {
+		bind(a.Type, fields[i], a.Direction)
+	}
+	if hasOut {
+		g.imports["database/sql"] = true
+	}
+	g.printf("\t_, err := ex.ExecContext(ctx, qry, args...)\n\tif err != nil {\n\t\terr = fmt.Errorf(\"%%s: %%w\", qry, err)\n\t}\n\treturn %s\n}\n", ret)
+	return nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"iter"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CompileDiagnostics are the compile errors and warnings of stored PL/SQL objects,
+// with the offending source lines.
+//
+// It is also the error returned (wrapping the ORA-24344 "success with compilation error")
+// by Exec of a CREATE OR REPLACE with the CheckCompileErrors option.
+type CompileDiagnostics struct {
+	Errors, Warnings []CompileError
+}
+
+// Error returns the errors, then the warnings, each with its source line and a caret under the position.
+func (cd CompileDiagnostics) Error() string {
+	var buf strings.Builder
+	for _, ces := range [][]CompileError{cd.Errors, cd.Warnings} {
+		for _, ce := range ces {
+			if buf.Len() != 0 {
+				buf.WriteByte('\n')
+			}
+			buf.WriteString(ce.Error())
+			if snippet := ce.Snippet(); snippet != "" {
+				buf.WriteByte('\n')
+				buf.WriteString(snippet)
+			}
+		}
+	}
+	return buf.String()
+}
+
+// Snippet returns the offending source line with a caret marking the position, such as
+//
+//	12 |   x := undefined_var;
+//	   |        ^
+//
+// It is empty if the Source is unknown.
+func (ce CompileError) Snippet() string {
+	if ce.Source == "" {
+		return ""
+	}
+	num := strconv.FormatInt(ce.Line, 10)
+	var buf strings.Builder
+	buf.WriteString(num)
+	buf.WriteString(" | ")
+	buf.WriteString(ce.Source)
+	buf.WriteByte('\n')
+	buf.WriteString(strings.Repeat(" ", len(num)))
+	buf.WriteString(" | ")
+	// Position is 1-based, in characters. Keep the tabs to align the caret.
+	col := int(ce.Position) - 1
+	for _, r := range ce.Source {
+		if col <= 0 {
+			break
+		}
+		col--
+		if r == '\t' {
+			buf.WriteByte('\t')
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	if col > 0 {
+		buf.WriteString(strings.Repeat(" ", col))
+	}
+	buf.WriteByte('^')
+	return buf.String()
+}
+
+var rCompileErrorText = regexp.MustCompile(`^([A-Z]{2,3})-[0-9]+: `)
+
+// parseCompileErrorText splits the "PLS-00201: identifier 'X' must be declared" text
+// to the facility ("PLS") and the message.
+func parseCompileErrorText(text string) (facility, message string) {
+	text = strings.TrimSpace(text)
+	m := rCompileErrorText.FindStringSubmatch(text)
+	if m == nil {
+		return "", text
+	}
+	return m[1], text[len(m[0]):]
+}
+
+const compileDiagnosticsQry = `SELECT e.owner, e.name, e.type, e.line, e.position, e.message_number AS code,
+       e.text, e.attribute, s.text AS source
+  FROM all_errors e
+  LEFT OUTER JOIN all_source s ON s.owner = e.owner AND s.name = e.name AND s.type = e.type AND s.line = e.line
+  WHERE e.owner = NVL(:1, SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA')) AND
+        e.name = NVL(:2, e.name) AND e.type = NVL(:3, e.type)
+  ORDER BY e.owner, e.name, e.type, e.sequence`
+
+type compileDiagRow struct {
+	Owner, Name, Type, Text, Attribute, Source string
+	Line, Position, Code                       int64
+}
+
+// GetCompileDiagnostics returns the compile errors and warnings of the owner's objects
+// (of the current schema if owner is empty) named name (all if empty),
+// with the offending source lines from ALL_SOURCE.
+//
+// Unquoted names are uppercased.
+func GetCompileDiagnostics(ctx context.Context, queryer Querier, owner, name string) (CompileDiagnostics, error) {
+	if queryer == nil {
+		return CompileDiagnostics{}, fmt.Errorf("nil queryer")
+	}
+	rows, err := queryer.QueryContext(ctx, compileDiagnosticsQry,
+		normalizeObjectName(owner), normalizeObjectName(name), "")
+	if err != nil {
+		return CompileDiagnostics{}, fmt.Errorf("%s: %w", compileDiagnosticsQry, err)
+	}
+	defer rows.Close()
+	return collectCompileDiagnostics(ScanEachSQL[compileDiagRow](rows))
+}
+
+func (c *conn) getCompileDiagnostics(ctx context.Context, owner, name, typ string) (CompileDiagnostics, error) {
+	stmt, err := c.PrepareContext(ctx, compileDiagnosticsQry)
+	if err != nil {
+		return CompileDiagnostics{}, err
+	}
+	defer stmt.Close()
+	rows, err := stmt.(*statement).QueryContext(ctx, []driver.NamedValue{
+		{Ordinal: 1, Value: owner}, {Ordinal: 2, Value: name}, {Ordinal: 3, Value: typ},
+	})
+	if err != nil {
+		return CompileDiagnostics{}, fmt.Errorf("%s: %w", compileDiagnosticsQry, err)
+	}
+	defer rows.Close()
+	return collectCompileDiagnostics(ScanEach[compileDiagRow](ctx, rows))
+}
+
+func collectCompileDiagnostics(seq iter.Seq2[compileDiagRow, error]) (CompileDiagnostics, error) {
+	var cd CompileDiagnostics
+	for row, err := range seq {
+		if err != nil {
+			return cd, err
+		}
+		ce := CompileError{
+			Owner: row.Owner, Name: row.Name, Type: row.Type, Text: row.Text,
+			Source: strings.TrimRight(row.Source, "\r\n"),
+			Line:   row.Line, Position: row.Position, Code: row.Code,
+			Warning: row.Attribute == "WARNING",
+		}
+		ce.Facility, ce.Message = parseCompileErrorText(ce.Text)
+		if ce.Warning {
+			cd.Warnings = append(cd.Warnings, ce)
+		} else {
+			cd.Errors = append(cd.Errors, ce)
+		}
+	}
+	return cd, nil
+}
+
+// normalizeObjectName removes the quotes of a quoted name, and uppercases an unquoted one.
+func normalizeObjectName(name string) string {
+	if len(name) > 1 && name[0] == '"' && name[len(name)-1] == '"' {
+		return name[1 : len(name)-1]
+	}
+	return strings.ToUpper(name)
+}
+
+var rCreatePLSQL = regexp.MustCompile(`(?is)^\s*(?:CREATE\s+(?:OR\s+REPLACE\s+)?(?:(?:NO\s+)?FORCE\s+|(?:NON)?EDITIONABLE\s+|EDITIONING\s+)*|(ALTER)\s+)` +
+	`(PACKAGE\s+BODY\b|TYPE\s+BODY\b|PACKAGE|TYPE|PROCEDURE|FUNCTION|TRIGGER|VIEW|LIBRARY|JAVA\s+SOURCE)\s+` +
+	`(?:IF\s+NOT\s+EXISTS\s+)?("[^"]+"|[\w$#]+)(?:\s*\.\s*("[^"]+"|[\w$#]+))?`)
+
+// parseCreatedObject returns the owner (empty if not qualified), name and type of the object
+// created (or altered) by the CREATE OR REPLACE (or ALTER) statement.
+// The type is empty for ALTER, as ALTER PACKAGE ... COMPILE compiles the body, too.
+func parseCreatedObject(qry string) (owner, name, typ string, ok bool) {
+	m := rCreatePLSQL.FindStringSubmatch(qry)
+	if m == nil {
+		return "", "", "", false
+	}
+	if m[1] == "" {
+		typ = strings.ToUpper(strings.Join(strings.Fields(m[2]), " "))
+	}
+	if m[4] == "" {
+		return "", normalizeObjectName(m[3]), typ, true
+	}
+	return normalizeObjectName(m[3]), normalizeObjectName(m[4]), typ, true
+}
+
+// compileError returns the CompileDiagnostics of the object created by qry, wrapped in the
+// ORA-24344 "success with compilation error" warning (returned as is if the object is unknown).
+func (c *conn) compileError(ctx context.Context, qry string, warning error) error {
+	owner, name, typ, ok := parseCreatedObject(qry)
+	if !ok {
+		return warning
+	}
+	cd, err := c.getCompileDiagnostics(ctx, owner, name, typ)
+	if err != nil {
+		return fmt.Errorf("%w (get compile errors: %w)", warning, err)
+	}
+	if len(cd.Errors) == 0 && len(cd.Warnings) == 0 {
+		return warning
+	}
+	return fmt.Errorf("%w:\n%w", warning, cd)
+}
+
+// isCompileWarning reports whether the error is the ORA-24344 "success with compilation error" warning.
+func isCompileWarning(err error) bool {
+	var oe *OraErr
+	return errors.As(err, &oe) && oe.IsWarning() && oe.Code() == 24344
+}
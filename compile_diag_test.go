@@ -0,0 +1,73 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import "testing"
+
+func TestCompileErrorSnippet(t *testing.T) {
+	for _, tc := range []struct {
+		CE   CompileError
+		Want string
+	}{
+		{CE: CompileError{Line: 3}, Want: ""},
+		{
+			CE:   CompileError{Line: 12, Position: 8, Source: "  x := undefined_var;"},
+			Want: "12 |   x := undefined_var;\n   |        ^",
+		},
+		{
+			CE:   CompileError{Line: 7, Position: 3, Source: "\t\tv NUMBR;"},
+			Want: "7 | \t\tv NUMBR;\n  | \t\t^",
+		},
+		{
+			CE:   CompileError{Line: 1, Position: 6, Source: "END"},
+			Want: "1 | END\n  |      ^",
+		},
+	} {
+		if got := tc.CE.Snippet(); got != tc.Want {
+			t.Errorf("%+v: got\n%s\nwanted\n%s", tc.CE, got, tc.Want)
+		}
+	}
+}
+
+func TestParseCompileErrorText(t *testing.T) {
+	for _, tc := range []struct {
+		Text, Facility, Message string
+	}{
+		{Text: "PLS-00201: identifier 'X' must be declared", Facility: "PLS", Message: "identifier 'X' must be declared"},
+		{Text: "PLW-06009: procedure \"P\" OTHERS handler does not end in RAISE", Facility: "PLW", Message: "procedure \"P\" OTHERS handler does not end in RAISE"},
+		{Text: "ORA-00942: table or view does not exist\n", Facility: "ORA", Message: "table or view does not exist"},
+		{Text: "PL/SQL: Statement ignored", Message: "PL/SQL: Statement ignored"},
+	} {
+		facility, message := parseCompileErrorText(tc.Text)
+		if facility != tc.Facility || message != tc.Message {
+			t.Errorf("%q: got %q, %q, wanted %q, %q", tc.Text, facility, message, tc.Facility, tc.Message)
+		}
+	}
+}
+
+func TestParseCreatedObject(t *testing.T) {
+	for _, tc := range []struct {
+		Qry               string
+		Owner, Name, Type string
+		OK                bool
+	}{
+		{Qry: "CREATE OR REPLACE PROCEDURE test_proc IS BEGIN NULL; END;", Name: "TEST_PROC", Type: "PROCEDURE", OK: true},
+		{Qry: "create or replace package body\n  scott.\"MyPkg\" AS END;", Owner: "SCOTT", Name: "MyPkg", Type: "PACKAGE BODY", OK: true},
+		{Qry: "CREATE OR REPLACE NONEDITIONABLE FUNCTION f RETURN NUMBER IS BEGIN RETURN 1; END;", Name: "F", Type: "FUNCTION", OK: true},
+		{Qry: "CREATE OR REPLACE FORCE VIEW v AS SELECT * FROM t", Name: "V", Type: "VIEW", OK: true},
+		{Qry: "CREATE TYPE body_t AS OBJECT (a NUMBER)", Name: "BODY_T", Type: "TYPE", OK: true},
+		{Qry: "ALTER PACKAGE p COMPILE", Name: "P", OK: true},
+		{Qry: "ALTER SESSION SET current_schema = x"},
+		{Qry: "CREATE TABLE t (a NUMBER)"},
+		{Qry: "BEGIN NULL; END;"},
+	} {
+		owner, name, typ, ok := parseCreatedObject(tc.Qry)
+		if owner != tc.Owner || name != tc.Name || typ != tc.Type || ok != tc.OK {
+			t.Errorf("%q: got %q.%q %q %t, wanted %q.%q %q %t",
+				tc.Qry, owner, name, typ, ok, tc.Owner, tc.Name, tc.Type, tc.OK)
+		}
+	}
+}
@@ -65,10 +65,12 @@ type conn struct {
 	params              dsn.ConnectionParams
 	mu                  sync.RWMutex
 	objTypes            map[string]*ObjectType
+	retiredObjTypes     []*ObjectType // dropped from objTypes, closed with the connection
 	tzOffSecs           int
 	inTransaction       bool
 	released            bool
 	tzValid             bool
+	dbmsOutput          bool // DBMS_OUTPUT is enabled on the session
 }
 
 func (c *conn) getError() error {
@@ -248,6 +250,10 @@ func (c *conn) closeNotLocking() error {
 		_ = v.Close()
 		delete(c.objTypes, k)
 	}
+	for _, t := range c.retiredObjTypes {
+		_ = t.Close()
+	}
+	c.retiredObjTypes = nil
 
 	// dpiConn_release decrements dpiConn's reference counting,
 	// and closes it when it reaches zero.
@@ -486,9 +492,21 @@ func (c *conn) init(ctx context.Context, isNew bool, onInit func(ctx context.Con
 		logger.Debug("init connection", "params", c.params)
 	}
 
-	if err := c.initTZ(); err != nil || onInit == nil {
+	if err := c.initTZ(); err != nil {
 		return err
 	}
+	if c.params.DbmsOutput {
+		if isNew || !c.params.CommonParams.InitOnNewConn {
+			if err := c.enableDbmsOutput(ctx); err != nil {
+				return err
+			}
+		} else {
+			c.dbmsOutput = true // enabled when the session was created
+		}
+	}
+	if onInit == nil {
+		return nil
+	}
 	if logger != nil {
 		logger.Debug("connection initialized", "conn", c, "haveOnInit", onInit != nil)
 	}
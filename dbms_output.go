@@ -0,0 +1,132 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/godror/godror/slog"
+)
+
+// DefaultDbmsOutputMaxBytes is the default cap of the DBMS_OUTPUT logged after an Exec
+// (see the dbmsOutputMaxBytes connection parameter).
+const DefaultDbmsOutputMaxBytes = 1 << 20
+
+const (
+	dbmsOutputEnableQry   = "BEGIN DBMS_OUTPUT.enable(NULL); END;"
+	dbmsOutputGetLinesQry = "BEGIN DBMS_OUTPUT.get_lines(:1, :2); END;"
+	// dbmsOutputPurgeQry drops the remaining lines from the buffer.
+	dbmsOutputPurgeQry = "BEGIN DBMS_OUTPUT.disable; DBMS_OUTPUT.enable(NULL); END;"
+)
+
+type dbmsOutputCtxKey struct{}
+
+// ContextWithDbmsOutput returns a context that enables (or disables) the draining
+// of DBMS_OUTPUT after each Exec using this context, overriding the dbmsOutput connection parameter.
+//
+// When enabled, DBMS_OUTPUT is enabled on the session (if not already) before the Exec,
+// and its lines are logged (with level Info, message "DBMS_OUTPUT" and the "line" attribute)
+// after the Exec to the logger of ContextWithLogger (or the connection's or the global logger),
+// up to dbmsOutputMaxBytes (DefaultDbmsOutputMaxBytes by default) per call, the rest is dropped.
+//
+// Use ContextWithDbmsOutput(ctx, false) or the NoDbmsOutput option to opt out for some calls.
+// As the lines are read from the session's buffer, ReadDbmsOutput does not see them anymore.
+func ContextWithDbmsOutput(ctx context.Context, enable bool) context.Context {
+	return context.WithValue(ctx, dbmsOutputCtxKey{}, enable)
+}
+
+// NoDbmsOutput is an option to not drain DBMS_OUTPUT after this call,
+// when it is enabled by the dbmsOutput connection parameter or ContextWithDbmsOutput.
+func NoDbmsOutput() Option { return func(o *stmtOptions) { o.noDbmsOutput = true } }
+
+// wantDbmsOutput reports whether DBMS_OUTPUT should be drained after the call.
+func (c *conn) wantDbmsOutput(ctx context.Context) bool {
+	if enable, ok := ctx.Value(dbmsOutputCtxKey{}).(bool); ok {
+		return enable
+	}
+	return c.params.DbmsOutput
+}
+
+// prepareDbmsOutput prepares the DBMS_OUTPUT call, without draining DBMS_OUTPUT after it.
+func (c *conn) prepareDbmsOutput(ctx context.Context, qry string) (*statement, error) {
+	stmt, err := c.PrepareContext(ctx, qry)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", qry, err)
+	}
+	st := stmt.(*statement)
+	st.noDbmsOutput = true
+	return st, nil
+}
+
+func (c *conn) execDbmsOutput(ctx context.Context, qry string) error {
+	st, err := c.prepareDbmsOutput(ctx, qry)
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+	if _, err = st.ExecContext(ctx, nil); err != nil {
+		return fmt.Errorf("%s: %w", qry, err)
+	}
+	return nil
+}
+
+// enableDbmsOutput enables DBMS_OUTPUT on the session, if it has not been enabled yet.
+func (c *conn) enableDbmsOutput(ctx context.Context) error {
+	if c.dbmsOutput {
+		return nil
+	}
+	if err := c.execDbmsOutput(ctx, dbmsOutputEnableQry); err != nil {
+		return err
+	}
+	c.dbmsOutput = true
+	return nil
+}
+
+// drainDbmsOutput reads the DBMS_OUTPUT lines in batches, and logs them to the logger,
+// up to the connection's DbmsOutputMaxBytes, purging the rest.
+func (c *conn) drainDbmsOutput(ctx context.Context, logger *slog.Logger) error {
+	const maxNumLines = 128
+	maxBytes := c.params.DbmsOutputMaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultDbmsOutputMaxBytes
+	}
+	lines := make([]string, maxNumLines)
+	var numLines int64
+	args := []driver.NamedValue{
+		{Ordinal: 1, Value: sql.Out{Dest: &lines}},
+		{Ordinal: 2, Value: sql.Out{Dest: &numLines, In: true}},
+	}
+	st, err := c.prepareDbmsOutput(ctx, dbmsOutputGetLinesQry)
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+	st.plSQLArrays = true
+	var size int
+	for {
+		numLines = int64(len(lines))
+		if _, err = st.ExecContext(ctx, args); err != nil {
+			return fmt.Errorf("%s: %w", dbmsOutputGetLinesQry, err)
+		}
+		for _, line := range lines[:int(numLines)] {
+			if size += len(line) + 1; size > maxBytes {
+				if logger != nil {
+					logger.WarnContext(ctx, "DBMS_OUTPUT truncated", "maxBytes", maxBytes)
+				}
+				return c.execDbmsOutput(ctx, dbmsOutputPurgeQry)
+			}
+			if logger != nil {
+				logger.InfoContext(ctx, "DBMS_OUTPUT", "line", line)
+			}
+		}
+		if int(numLines) < len(lines) {
+			return nil
+		}
+	}
+}
@@ -37,6 +37,8 @@
 //	stmtCacheSize=
 //	charset=UTF-8
 //	noBreakOnContextCancel=
+//	dbmsOutput=0
+//	dbmsOutputMaxBytes=
 //
 // These are the defaults.
 // For external authentication, user and password should be empty
@@ -206,6 +208,7 @@ type drv struct {
 	dpiContext    *C.dpiContext
 	pools         map[string]*connPool
 	timezones     map[string]locationWithOffSecs
+	objTypeDescs  objTypeDescCache
 	clientVersion VersionInfo
 	mu            sync.RWMutex
 }
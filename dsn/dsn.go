@@ -59,10 +59,15 @@ type CommonSimpleParams struct {
 	Charset                 string
 	// StmtCacheSize of 0 means the default, -1 to disable the stmt cache completely
 	StmtCacheSize int
+	// DbmsOutputMaxBytes caps the DBMS_OUTPUT logged after each Exec, if DbmsOutput is set.
+	// 0 means the default.
+	DbmsOutputMaxBytes int
 	// true: OnInit will be called only by the new session / false: OnInit will called by new or pooled connection
 	InitOnNewConn                               bool
 	EnableEvents, NoTZCheck, PerSessionTimezone bool
 	NoBreakOnContextCancel                      bool
+	// DbmsOutput enables DBMS_OUTPUT on session init, and logs the lines after each Exec.
+	DbmsOutput bool
 }
 
 // CommonParams holds the common parameters for pooled or standalone connections.
@@ -141,6 +146,12 @@ func (P CommonSimpleParams) String() string {
 	if P.NoBreakOnContextCancel {
 		q.Add("noBreakOnContextCancel", "1")
 	}
+	if P.DbmsOutput {
+		q.Add("dbmsOutput", "1")
+	}
+	if P.DbmsOutputMaxBytes != 0 {
+		q.Add("dbmsOutputMaxBytes", strconv.Itoa(P.DbmsOutputMaxBytes))
+	}
 
 	s = q.String()
 	cacheCPSMu.Lock()
@@ -400,6 +411,12 @@ func (P ConnectionParams) string(class, withPassword bool) string {
 	if P.NoBreakOnContextCancel {
 		q.Add("noBreakOnContextCancel", "1")
 	}
+	if P.DbmsOutput {
+		q.Add("dbmsOutput", "1")
+	}
+	if P.DbmsOutputMaxBytes != 0 {
+		q.Add("dbmsOutputMaxBytes", strconv.Itoa(P.DbmsOutputMaxBytes))
+	}
 	q.Values["onInit"] = P.OnInitStmts
 	if P.ConfigDir != "" {
 		q.Add("configDir", P.ConfigDir)
@@ -547,6 +564,7 @@ func Parse(dataSourceName string) (ConnectionParams, error) {
 		{&P.PerSessionTimezone, "perSessionTimezone"},
 		{&P.InitOnNewConn, "initOnNewConnection"},
 		{&P.NoBreakOnContextCancel, "noBreakOnContextCancel"},
+		{&P.DbmsOutput, "dbmsOutput"},
 	}
 	if ar := q.Get("adminRole"); len(ar) > 3 && strings.EqualFold(ar[:3], "SYS") {
 		P.AdminRole = AdminRole(strings.ToUpper(ar))
@@ -629,6 +647,7 @@ func Parse(dataSourceName string) (ConnectionParams, error) {
 		{&P.SessionIncrement, "poolIncrement"},
 		{&P.SessionIncrement, "sessionIncrement"},
 		{&P.StmtCacheSize, "stmtCacheSize"},
+		{&P.DbmsOutputMaxBytes, "dbmsOutputMaxBytes"},
 	} {
 		s := q.Get(task.Key)
 		if s == "" {
@@ -0,0 +1,173 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrETagMismatch is returned when the document has been changed since it was read:
+// the ETAG in its _metadata does not match the one in the database (ORA-42699).
+//
+// Get the document again, reapply the changes and retry.
+var ErrETagMismatch = errors.New("duality view document ETAG mismatch")
+
+// DualityMetadata is the "_metadata" of a JSON relational duality view document.
+//
+// Embed it into the document struct as
+//
+//	Metadata godror.DualityMetadata `json:"_metadata,omitempty"`
+//
+// to keep the ETAG read by Get, so Update fails with ErrETagMismatch if the document
+// has been changed by someone else in the meantime.
+type DualityMetadata struct {
+	ETag string `json:"etag,omitempty"`
+	AsOf string `json:"asof,omitempty"`
+}
+
+// DualityView is a JSON relational duality view (23ai):
+// a view with one JSON column, DATA, whose documents are identified by their "_id" field.
+//
+// The documents are mapped to Go values with JSONMarshal/JSONUnmarshal (honoring the json struct tags).
+type DualityView struct {
+	// Name of the view, used verbatim in the SQL statements.
+	Name string
+}
+
+// Get the document with the given _id into dest (a pointer, usually to a struct).
+//
+// Returns sql.ErrNoRows if there is no such document.
+func (v DualityView) Get(ctx context.Context, q Querier, id, dest interface{}) error {
+	qry := "SELECT v.data FROM " + v.Name + ` v WHERE v.data."_id" = :1` //nolint:gas
+	rows, err := q.QueryContext(ctx, qry, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", qry, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return fmt.Errorf("%s: %w", qry, err)
+		}
+		return sql.ErrNoRows
+	}
+	// The JSON is valid only till the rows are open.
+	var j JSON
+	if err = rows.Scan(&j); err != nil {
+		return fmt.Errorf("%s: %w", qry, err)
+	}
+	if err = JSONUnmarshal(j, dest); err != nil {
+		return fmt.Errorf("%s(%v): %w", v.Name, id, err)
+	}
+	return rows.Close()
+}
+
+// Insert the document (anything JSONMarshal accepts).
+func (v DualityView) Insert(ctx context.Context, ex Execer, doc interface{}) error {
+	qry := "INSERT INTO " + v.Name + " (data) VALUES (:1)" //nolint:gas
+	if _, err := ex.ExecContext(ctx, qry, JSONValue{Value: doc}); err != nil {
+		return fmt.Errorf("%s: %w", qry, err)
+	}
+	return nil
+}
+
+// Update replaces the document with the given _id.
+//
+// If the document has a _metadata.etag (see DualityMetadata), the database checks it,
+// and Update returns ErrETagMismatch if the document has been changed since.
+// Returns sql.ErrNoRows if there is no such document.
+func (v DualityView) Update(ctx context.Context, ex Execer, id, doc interface{}) error {
+	qry := "UPDATE " + v.Name + ` v SET v.data = :1 WHERE v.data."_id" = :2` //nolint:gas
+	res, err := ex.ExecContext(ctx, qry, JSONValue{Value: doc}, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", qry, etagError(err))
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("%s: RowsAffected: %w", qry, err)
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// Delete the document with the given _id.
+//
+// If etag is not empty, the document is deleted only if its ETAG still matches,
+// and ErrETagMismatch is returned otherwise.
+// Returns sql.ErrNoRows if there is no such document.
+func (v DualityView) Delete(ctx context.Context, ex Execer, id interface{}, etag string) error {
+	qry := "DELETE FROM " + v.Name + ` v WHERE v.data."_id" = :1` //nolint:gas
+	params := []interface{}{id}
+	if etag != "" {
+		qry += " AND JSON_VALUE(v.data, '$._metadata.etag') = :2"
+		params = append(params, etag)
+	}
+	res, err := ex.ExecContext(ctx, qry, params...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", qry, etagError(err))
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("%s: RowsAffected: %w", qry, err)
+	} else if n != 0 {
+		return nil
+	}
+	if etag == "" {
+		return sql.ErrNoRows
+	}
+	// distinguish between a missing and a changed document
+	var n int64
+	qry = "BEGIN SELECT COUNT(0) INTO :1 FROM " + v.Name + ` v WHERE v.data."_id" = :2; END;` //nolint:gas
+	if _, err = ex.ExecContext(ctx, qry, sql.Out{Dest: &n}, id); err != nil {
+		return fmt.Errorf("%s: %w", qry, err)
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return fmt.Errorf("%s(%v): %w", v.Name, id, ErrETagMismatch)
+}
+
+// Modify reads the document with the given _id into dest, calls modify and writes it back,
+// retrying (at most maxTries times, at least once) from the read when the ETAG does not match.
+//
+// dest should have a _metadata field (see DualityMetadata) for the ETAG check.
+func (v DualityView) Modify(ctx context.Context, db interface {
+	Execer
+	Querier
+}, id, dest interface{}, modify func() error, maxTries int) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("dest must be a non-nil pointer, got %T", dest)
+	}
+	var err error
+	for i := 0; i < maxTries || i == 0; i++ {
+		// do not keep the fields missing from the fresh document
+		rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+		if err = v.Get(ctx, db, id, dest); err != nil {
+			return err
+		}
+		if err = modify(); err != nil {
+			return err
+		}
+		if err = v.Update(ctx, db, id, dest); !errors.Is(err, ErrETagMismatch) {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+	}
+	return err
+}
+
+// etagError marks ORA-42699 as ErrETagMismatch.
+func etagError(err error) error {
+	if oerr, ok := AsOraErr(err); ok && oerr.Code() == 42699 {
+		return fmt.Errorf("%w: %w", ErrETagMismatch, err)
+	}
+	return err
+}
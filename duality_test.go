@@ -0,0 +1,92 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestETagError(t *testing.T) {
+	mismatch := fmt.Errorf("exec: %w", &OraErr{code: 42699, message: "Cannot update JSON Relational Duality View"})
+	if err := etagError(mismatch); !errors.Is(err, ErrETagMismatch) {
+		t.Errorf("%v is not ErrETagMismatch", err)
+	} else if oerr, ok := AsOraErr(err); !ok || oerr.Code() != 42699 {
+		t.Errorf("%v lost the OraErr", err)
+	}
+	if err := etagError(&OraErr{code: 1}); errors.Is(err, ErrETagMismatch) {
+		t.Errorf("%v is ErrETagMismatch", err)
+	}
+}
+
+func TestDualityMetadataJSON(t *testing.T) {
+	type doc struct {
+		ID       int             `json:"_id"`
+		Name     string          `json:"name"`
+		Metadata DualityMetadata `json:"_metadata,omitempty"`
+	}
+	var d doc
+	if err := jsonDecode(map[string]interface{}{
+		"_id": Number("1"), "name": "x",
+		"_metadata": map[string]interface{}{"etag": "E0A1", "asof": "0000"},
+	}, &d); err != nil {
+		t.Fatal(err)
+	}
+	if d.Metadata.ETag != "E0A1" || d.ID != 1 {
+		t.Errorf("got %+v", d)
+	}
+	jv, err := JSONMarshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := jv.Value.(map[string]interface{})["_metadata"].(map[string]interface{})
+	if md["etag"] != "E0A1" {
+		t.Errorf("got %v", md)
+	}
+}
+
+type fakeResult struct {
+	n   int64
+	err error
+}
+
+func (r fakeResult) LastInsertId() (int64, error) { return 0, ErrNotSupported }
+func (r fakeResult) RowsAffected() (int64, error) { return r.n, r.err }
+
+type fakeExecer func(qry string) (sql.Result, error)
+
+func (f fakeExecer) ExecContext(_ context.Context, qry string, _ ...interface{}) (sql.Result, error) {
+	return f(qry)
+}
+
+func TestDualityViewErrors(t *testing.T) {
+	ctx := context.Background()
+	v := DualityView{Name: "emp_dv"}
+	errRows, errCount := errors.New("rows affected"), errors.New("count")
+	badResult := fakeExecer(func(string) (sql.Result, error) { return fakeResult{err: errRows}, nil })
+	if err := v.Update(ctx, badResult, 1, nil); !errors.Is(err, errRows) {
+		t.Errorf("Update: got %v, wanted %v", err, errRows)
+	}
+	if err := v.Delete(ctx, badResult, 1, ""); !errors.Is(err, errRows) {
+		t.Errorf("Delete: got %v, wanted %v", err, errRows)
+	}
+	badCount := fakeExecer(func(qry string) (sql.Result, error) {
+		if strings.HasPrefix(qry, "DELETE") {
+			return fakeResult{}, nil
+		}
+		return nil, errCount
+	})
+	if err := v.Delete(ctx, badCount, 1, "E0A1"); !errors.Is(err, errCount) {
+		t.Errorf("Delete with etag: got %v, wanted %v", err, errCount)
+	}
+	if err := v.Delete(ctx, fakeExecer(func(string) (sql.Result, error) { return fakeResult{}, nil }), 1, ""); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Delete of missing: got %v, wanted sql.ErrNoRows", err)
+	}
+}
@@ -110,6 +110,11 @@ void godror_dpiJson_setBool(dpiJsonNode *topNode, dpiData *data) {
     topNode->value->asBoolean = data->value.asBoolean;
 }
 
+void godror_dpiJson_setNull(dpiJsonNode *topNode) {
+    topNode->oracleTypeNum = DPI_ORACLE_TYPE_NONE;
+    topNode->nativeTypeNum = DPI_NATIVE_TYPE_NULL;
+}
+
 void godror_dpiJson_setString(dpiJsonNode *topNode, dpiData *data) {
     uint32_t size = data->value.asBytes.length;
     topNode->oracleTypeNum = DPI_ORACLE_TYPE_VARCHAR;
@@ -578,16 +583,15 @@ func (j JSONObject) GetValue() (m map[string]interface{}, err error) {
 	return m, nil
 }
 
-// GetInto takes pointer to struct and populates the fields.
-// The struct name fields are matched with DB JSON keynames but
-// not the struct json tags.
+// GetInto takes pointer to struct and populates the fields,
+// matching the DB JSON keynames with the struct json tags (or field names).
+//
+// Deprecated: use Unmarshal, which returns the error, too.
 func (j JSONObject) GetInto(v interface{}) {
-	rv := reflect.ValueOf(v).Elem()
-	for _, f := range jsonObjectFields(j.dpiJsonObject) {
-		var d Data
-		jsonNodeToData(&d, f.Value)
-		// nosemgrep: go.lang.security.audit.unsafe-reflect-by-name.unsafe-reflect-by-name
-		rv.FieldByName(f.Name).Set(reflect.ValueOf(d.Get()))
+	if err := j.Unmarshal(v); err != nil {
+		if logger := getLogger(context.TODO()); logger != nil {
+			logger.Error("JSONObject.GetInto", "error", err)
+		}
 	}
 }
 
@@ -621,6 +625,8 @@ func jsonObjectFields(obj *C.dpiJsonObject) []jsonField {
 // Caller has to explicitly free using godror_dpiJsonfreeMem
 func populateJSONNode(jsonnode *C.dpiJsonNode, in interface{}) error {
 	switch x := in.(type) {
+	case nil:
+		C.godror_dpiJson_setNull(jsonnode)
 	case []interface{}:
 		arr, _ := in.([]interface{})
 		C.godror_dpiJsonArray_initialize((**C.dpiJsonNode)(unsafe.Pointer(&jsonnode)), C.uint32_t(len(arr)))
@@ -716,7 +722,15 @@ func populateJSONNode(jsonnode *C.dpiJsonNode, in interface{}) error {
 		}
 		C.godror_dpiJson_setBool(jsonnode, &(data.dpiData))
 	default:
-		return fmt.Errorf("unsupported type %T", in)
+		// structs, pointers, typed slices and maps
+		val, err := jsonEncode(reflect.ValueOf(in))
+		if err != nil {
+			return fmt.Errorf("%T: %w", in, err)
+		}
+		if reflect.TypeOf(val) == reflect.TypeOf(in) {
+			return fmt.Errorf("unsupported type %T", in)
+		}
+		return populateJSONNode(jsonnode, val)
 	}
 	return nil
 }
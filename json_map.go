@@ -0,0 +1,657 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JSONUnmarshal decodes the JSON document into v (a non-nil pointer),
+// the same way as encoding/json.Unmarshal does: honoring the `json:"name"` struct tags,
+// case-insensitive field matching, embedded structs, and recursing into
+// nested structs, slices, arrays, maps and pointers.
+//
+// Besides the encoding/json types, the Oracle extended scalar types are decoded into
+// time.Time (TIMESTAMP), time.Duration (INTERVAL DAY TO SECOND), []byte (RAW) and Number.
+func JSONUnmarshal(j JSON, v interface{}) error {
+	val, err := j.GetValue(JSONOptNumberAsString)
+	if err != nil {
+		return err
+	}
+	return jsonDecode(val, v)
+}
+
+// Unmarshal the JSONObject into v, see JSONUnmarshal.
+//
+// The numbers are decoded as they are in the JSONObject, so get it with JSONOptNumberAsString
+// (as JSONUnmarshal does) to keep the NUMBERs exact: an integer beyond the float64 precision
+// is refused instead of being silently rounded.
+func (j JSONObject) Unmarshal(v interface{}) error {
+	m, err := j.GetValue()
+	if err != nil {
+		return err
+	}
+	return jsonDecode(m, v)
+}
+
+// JSONMarshal converts v into a JSONValue for binding,
+// the same way as encoding/json.Marshal does: honoring the `json:"name,omitempty"` struct tags.
+//
+// time.Time, time.Duration, []byte and Number are kept as is,
+// to be stored as the Oracle extended scalar types.
+func JSONMarshal(v interface{}) (JSONValue, error) {
+	val, err := jsonEncode(reflect.ValueOf(v))
+	if err != nil {
+		return JSONValue{}, err
+	}
+	return JSONValue{Value: val}, nil
+}
+
+var (
+	typTime          = reflect.TypeOf(time.Time{})
+	typDuration      = reflect.TypeOf(time.Duration(0))
+	typNumber        = reflect.TypeOf(Number(""))
+	typJSONNumber    = reflect.TypeOf(json.Number(""))
+	typBytes         = reflect.TypeOf([]byte(nil))
+	typJSONMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typTextMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// jsonEncode converts rv into a tree of map[string]interface{}, []interface{} and scalars,
+// as accepted by populateJSONNode.
+func jsonEncode(rv reflect.Value) (interface{}, error) {
+	if !rv.IsValid() {
+		return nil, nil
+	}
+	switch rv.Type() {
+	case typTime, typDuration, typNumber:
+		return rv.Interface(), nil
+	case typJSONNumber:
+		return Number(rv.String()), nil
+	case typBytes:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return rv.Bytes(), nil
+	}
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		if rv.Kind() == reflect.Ptr && !rv.Type().Implements(typJSONMarshaler) && !rv.Type().Implements(typTextMarshaler) {
+			return jsonEncode(rv.Elem())
+		} else if rv.Kind() == reflect.Interface {
+			return jsonEncode(rv.Elem())
+		}
+	}
+	if rv.Type().Implements(typJSONMarshaler) {
+		b, err := rv.Interface().(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		var v interface{}
+		if err = dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		return jsonFromStd(v), nil
+	}
+	if rv.Type().Implements(typTextMarshaler) {
+		b, err := rv.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("unsupported value: %v", f)
+		}
+		return f, nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		fallthrough
+	case reflect.Array:
+		arr := make([]interface{}, rv.Len())
+		for i := range arr {
+			var err error
+			if arr[i], err = jsonEncode(rv.Index(i)); err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		return arr, nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		m := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			k, err := jsonMapKey(iter.Key())
+			if err != nil {
+				return nil, err
+			}
+			if m[k], err = jsonEncode(iter.Value()); err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+		}
+		return m, nil
+	case reflect.Struct:
+		fields := jsonStructFields(rv.Type())
+		m := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			fv, ok := fieldByIndex(rv, f.Index)
+			if !ok {
+				continue
+			}
+			if f.OmitEmpty && isEmptyValue(fv) || f.OmitZero && fv.IsZero() {
+				continue
+			}
+			var err error
+			if m[f.Name], err = jsonEncode(fv); err != nil {
+				return nil, fmt.Errorf("%s: %w", f.Name, err)
+			}
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", rv.Type())
+}
+
+// jsonFromStd converts the json.Number values of an encoding/json decoded tree to Number.
+func jsonFromStd(v interface{}) interface{} {
+	switch x := v.(type) {
+	case json.Number:
+		return Number(x)
+	case []interface{}:
+		for i, e := range x {
+			x[i] = jsonFromStd(e)
+		}
+	case map[string]interface{}:
+		for k, e := range x {
+			x[k] = jsonFromStd(e)
+		}
+	}
+	return v
+}
+
+func jsonMapKey(k reflect.Value) (string, error) {
+	if k.Kind() == reflect.String {
+		return k.String(), nil
+	}
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(k.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported map key type %s", k.Type())
+}
+
+// isEmptyValue is the same as encoding/json's.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+// jsonDecode decodes the tree (as returned by JSON.GetValue) into v, which must be a non-nil pointer.
+func jsonDecode(src, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("JSON decode needs a non-nil pointer, got %T", v)
+	}
+	return jsonDecodeValue("", src, rv.Elem())
+}
+
+func jsonDecodeValue(path string, src interface{}, rv reflect.Value) error {
+	if src == nil {
+		switch rv.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice:
+			rv.Set(reflect.Zero(rv.Type()))
+		}
+		return nil
+	}
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return jsonDecodeValue(path, src, rv.Elem())
+	}
+	mismatch := func() error {
+		return fmt.Errorf("%s: cannot decode %T into %s", jsonPath(path), src, rv.Type())
+	}
+	if rv.Kind() == reflect.Interface && rv.NumMethod() == 0 {
+		rv.Set(reflect.ValueOf(src))
+		return nil
+	}
+
+	switch rv.Type() {
+	case typTime:
+		switch x := src.(type) {
+		case time.Time:
+			rv.Set(reflect.ValueOf(x))
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, x)
+			if err != nil {
+				return fmt.Errorf("%s: %w", jsonPath(path), err)
+			}
+			rv.Set(reflect.ValueOf(t))
+		default:
+			return mismatch()
+		}
+		return nil
+	case typDuration:
+		switch x := src.(type) {
+		case time.Duration:
+			rv.SetInt(int64(x))
+		case string:
+			d, err := time.ParseDuration(x)
+			if err != nil {
+				return fmt.Errorf("%s: %w", jsonPath(path), err)
+			}
+			rv.SetInt(int64(d))
+		default:
+			return mismatch()
+		}
+		return nil
+	case typNumber, typJSONNumber:
+		s, ok := jsonNumberString(src)
+		if !ok {
+			return mismatch()
+		}
+		rv.SetString(s)
+		return nil
+	case typBytes:
+		switch x := src.(type) {
+		case []byte:
+			rv.SetBytes(append([]byte(nil), x...))
+		case string:
+			b, err := base64.StdEncoding.DecodeString(x)
+			if err != nil {
+				return fmt.Errorf("%s: %w", jsonPath(path), err)
+			}
+			rv.SetBytes(b)
+		default:
+			return mismatch()
+		}
+		return nil
+	}
+	if rv.CanAddr() {
+		if u, ok := rv.Addr().Interface().(json.Unmarshaler); ok {
+			b, err := json.Marshal(src)
+			if err != nil {
+				return fmt.Errorf("%s: %w", jsonPath(path), err)
+			}
+			return u.UnmarshalJSON(b)
+		}
+		if u, ok := rv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			if s, isString := src.(string); isString {
+				return u.UnmarshalText([]byte(s))
+			}
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		b, ok := src.(bool)
+		if !ok {
+			return mismatch()
+		}
+		rv.SetBool(b)
+	case reflect.String:
+		s, ok := src.(string)
+		if !ok {
+			return mismatch()
+		}
+		rv.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s, ok := jsonNumberString(src)
+		if !ok {
+			return mismatch()
+		}
+		if jsonInexactInt(src) {
+			return fmt.Errorf("%s: %s is beyond the float64 precision, use JSONOptNumberAsString", jsonPath(path), s)
+		}
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			f, fErr := strconv.ParseFloat(s, 64)
+			if fErr != nil || f != math.Trunc(f) || f < math.MinInt64 || f > math.MaxInt64 {
+				return fmt.Errorf("%s: %w", jsonPath(path), err)
+			}
+			i = int64(f)
+		}
+		if rv.OverflowInt(i) {
+			return fmt.Errorf("%s: %s overflows %s", jsonPath(path), s, rv.Type())
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s, ok := jsonNumberString(src)
+		if !ok {
+			return mismatch()
+		}
+		if jsonInexactInt(src) {
+			return fmt.Errorf("%s: %s is beyond the float64 precision, use JSONOptNumberAsString", jsonPath(path), s)
+		}
+		u, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			f, fErr := strconv.ParseFloat(s, 64)
+			if fErr != nil || f != math.Trunc(f) || f < 0 || f > math.MaxUint64 {
+				return fmt.Errorf("%s: %w", jsonPath(path), err)
+			}
+			u = uint64(f)
+		}
+		if rv.OverflowUint(u) {
+			return fmt.Errorf("%s: %s overflows %s", jsonPath(path), s, rv.Type())
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		s, ok := jsonNumberString(src)
+		if !ok {
+			return mismatch()
+		}
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s: %w", jsonPath(path), err)
+		}
+		rv.SetFloat(f)
+	case reflect.Slice:
+		arr, ok := src.([]interface{})
+		if !ok {
+			return mismatch()
+		}
+		sl := reflect.MakeSlice(rv.Type(), len(arr), len(arr))
+		for i, e := range arr {
+			if err := jsonDecodeValue(path+"["+strconv.Itoa(i)+"]", e, sl.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(sl)
+	case reflect.Array:
+		arr, ok := src.([]interface{})
+		if !ok {
+			return mismatch()
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if i >= len(arr) {
+				rv.Index(i).Set(reflect.Zero(rv.Type().Elem()))
+				continue
+			}
+			if err := jsonDecodeValue(path+"["+strconv.Itoa(i)+"]", arr[i], rv.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		m, ok := src.(map[string]interface{})
+		if !ok {
+			return mismatch()
+		}
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMapWithSize(rv.Type(), len(m)))
+		}
+		kt, et := rv.Type().Key(), rv.Type().Elem()
+		for k, e := range m {
+			kv := reflect.New(kt).Elem()
+			if err := jsonDecodeMapKey(k, kv); err != nil {
+				return fmt.Errorf("%s: %w", jsonPath(path), err)
+			}
+			ev := reflect.New(et).Elem()
+			if err := jsonDecodeValue(path+"."+k, e, ev); err != nil {
+				return err
+			}
+			rv.SetMapIndex(kv, ev)
+		}
+	case reflect.Struct:
+		m, ok := src.(map[string]interface{})
+		if !ok {
+			return mismatch()
+		}
+		fields := jsonStructFields(rv.Type())
+		for k, e := range m {
+			f := findJSONField(fields, k)
+			if f == nil {
+				continue
+			}
+			fv, err := fieldByIndexAlloc(rv, f.Index)
+			if err != nil {
+				return fmt.Errorf("%s: %w", jsonPath(path+"."+k), err)
+			}
+			if err := jsonDecodeValue(path+"."+k, e, fv); err != nil {
+				return err
+			}
+		}
+	default:
+		return mismatch()
+	}
+	return nil
+}
+
+func jsonPath(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return "$" + path
+}
+
+// jsonNumberString returns the number as string.
+func jsonNumberString(src interface{}) (string, bool) {
+	switch x := src.(type) {
+	case Number:
+		return string(x), true
+	case json.Number:
+		return string(x), true
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(x), 'g', -1, 32), true
+	case int64:
+		return strconv.FormatInt(x, 10), true
+	case uint64:
+		return strconv.FormatUint(x, 10), true
+	}
+	return "", false
+}
+
+// jsonInexactInt reports whether src is a float64 too big to hold an exact integer.
+func jsonInexactInt(src interface{}) bool {
+	f, ok := src.(float64)
+	return ok && math.Abs(f) > 1<<53
+}
+
+func jsonDecodeMapKey(k string, kv reflect.Value) error {
+	if kv.Kind() == reflect.String {
+		kv.SetString(k)
+		return nil
+	}
+	if u, ok := kv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(k))
+	}
+	switch kv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(k, 10, 64)
+		if err != nil || kv.OverflowInt(i) {
+			return fmt.Errorf("invalid map key %q for %s", k, kv.Type())
+		}
+		kv.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(k, 10, 64)
+		if err != nil || kv.OverflowUint(u) {
+			return fmt.Errorf("invalid map key %q for %s", k, kv.Type())
+		}
+		kv.SetUint(u)
+		return nil
+	}
+	return fmt.Errorf("unsupported map key type %s", kv.Type())
+}
+
+// jsonStructField is a struct field as seen by JSON.
+type jsonStructField struct {
+	Name                string
+	Index               []int
+	OmitEmpty, OmitZero bool
+}
+
+var jsonFieldsCache sync.Map // map[reflect.Type][]jsonStructField
+
+// jsonStructFields returns the JSON fields of the struct type, following the encoding/json rules:
+// the name is from the json tag (or the field name), "-" skips the field,
+// and the fields of embedded structs without a name are promoted.
+// Of the fields with the same name, the shallowest wins; at equal depth the tagged one,
+// and if that is still ambiguous, all of them are dropped.
+func jsonStructFields(t reflect.Type) []jsonStructField {
+	if f, ok := jsonFieldsCache.Load(t); ok {
+		return f.([]jsonStructField)
+	}
+	type candidate struct {
+		jsonStructField
+		tagged bool
+	}
+	var fields []candidate
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+				walk(ft, append(append([]int(nil), index...), i))
+				continue
+			}
+			if !sf.IsExported() {
+				continue
+			}
+			f := candidate{
+				jsonStructField: jsonStructField{Name: name, Index: append(append([]int(nil), index...), i)},
+				tagged:          name != "",
+			}
+			if f.Name == "" {
+				f.Name = sf.Name
+			}
+			for _, o := range strings.Split(opts, ",") {
+				switch o {
+				case "omitempty":
+					f.OmitEmpty = true
+				case "omitzero":
+					f.OmitZero = true
+				}
+			}
+			fields = append(fields, f)
+		}
+	}
+	walk(t, nil)
+
+	type rank struct{ depth, count, tagged int }
+	ranks := make(map[string]rank, len(fields))
+	for _, f := range fields {
+		r, ok := ranks[f.Name]
+		if d := len(f.Index); !ok || d < r.depth {
+			r = rank{depth: d}
+		} else if d > r.depth {
+			continue
+		}
+		r.count++
+		if f.tagged {
+			r.tagged++
+		}
+		ranks[f.Name] = r
+	}
+	kept := make([]jsonStructField, 0, len(fields))
+	for _, f := range fields {
+		r := ranks[f.Name]
+		if len(f.Index) == r.depth && (r.count == 1 || r.tagged == 1 && f.tagged) {
+			kept = append(kept, f.jsonStructField)
+		}
+	}
+	jsonFieldsCache.Store(t, kept)
+	return kept
+}
+
+// findJSONField returns the field with exactly the name, or case-insensitively.
+func findJSONField(fields []jsonStructField, name string) *jsonStructField {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i]
+		}
+	}
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, name) {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// fieldByIndex returns the field, or false if it is behind a nil embedded pointer.
+func fieldByIndex(rv reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return reflect.Value{}, false
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv, true
+}
+
+// fieldByIndexAlloc returns the field, allocating the nil embedded pointers.
+func fieldByIndexAlloc(rv reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				if !rv.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded pointer to unexported struct %s", rv.Type().Elem())
+				}
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type jsonTestBase struct {
+	ID int64 `json:"id"`
+}
+
+type jsonTestItem struct {
+	SKU   string  `json:"sku"`
+	Price Number  `json:"price"`
+	Qty   *uint16 `json:"qty,omitempty"`
+}
+
+type jsonTestOrder struct {
+	jsonTestBase
+	Customer string            `json:"customer_name"`
+	Note     string            `json:"note,omitempty"`
+	Items    []jsonTestItem    `json:"items"`
+	Attrs    map[string]string `json:"attrs,omitempty"`
+	Created  time.Time         `json:"created"`
+	Wait     time.Duration     `json:"wait"`
+	Raw      []byte            `json:"raw"`
+	Secret   string            `json:"-"`
+	Plain    float64
+	unexp    int
+}
+
+func TestJSONMapRoundTrip(t *testing.T) {
+	qty := uint16(3)
+	in := jsonTestOrder{
+		jsonTestBase: jsonTestBase{ID: 42},
+		Customer:     "Árvíztűrő",
+		Items: []jsonTestItem{
+			{SKU: "a-1", Price: "1.25", Qty: &qty},
+			{SKU: "b-2", Price: "10"},
+		},
+		Created: time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC),
+		Wait:    90 * time.Second,
+		Raw:     []byte{0, 1, 2},
+		Secret:  "hidden",
+		Plain:   0.5,
+		unexp:   1,
+	}
+	jv, err := JSONMarshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := jv.Value.(map[string]interface{})
+	if !ok {
+		t.Fatalf("got %T, wanted map", jv.Value)
+	}
+	for _, k := range []string{"id", "customer_name", "items", "created", "wait", "raw", "Plain"} {
+		if _, ok := m[k]; !ok {
+			t.Errorf("missing key %q from %v", k, m)
+		}
+	}
+	for _, k := range []string{"note", "attrs", "Secret", "-", "unexp", "jsonTestBase"} {
+		if _, ok := m[k]; ok {
+			t.Errorf("unwanted key %q in %v", k, m)
+		}
+	}
+	items := m["items"].([]interface{})
+	if _, ok := items[1].(map[string]interface{})["qty"]; ok {
+		t.Error("omitempty nil pointer is present")
+	}
+
+	var out jsonTestOrder
+	if err := jsonDecode(jv.Value, &out); err != nil {
+		t.Fatal(err)
+	}
+	in.Secret, in.unexp = "", 0
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("got\n%#v\nwanted\n%#v", out, in)
+	}
+}
+
+func TestJSONDecodeTree(t *testing.T) {
+	// as JSON.GetValue(JSONOptNumberAsString) returns it
+	src := map[string]interface{}{
+		"ID":            Number("7"),
+		"CUSTOMER_NAME": "case insensitive",
+		"items": []interface{}{
+			map[string]interface{}{"sku": "x", "price": Number("2.5"), "qty": Number("4")},
+		},
+		"attrs":   map[string]interface{}{"k": "v"},
+		"created": "2026-01-02T03:04:05Z",
+		"Plain":   Number("1e3"),
+		"unknown": true,
+	}
+	var out jsonTestOrder
+	if err := jsonDecode(src, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ID != 7 || out.Customer != "case insensitive" || out.Plain != 1000 ||
+		len(out.Items) != 1 || out.Items[0].Qty == nil || *out.Items[0].Qty != 4 ||
+		out.Attrs["k"] != "v" || !out.Created.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("got %#v", out)
+	}
+
+	var generic interface{}
+	if err := jsonDecode(src, &generic); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(generic, src) {
+		t.Errorf("interface{}: got %#v", generic)
+	}
+
+	for name, tc := range map[string]struct {
+		Src  interface{}
+		Dest interface{}
+	}{
+		"string into int":   {Src: map[string]interface{}{"id": "x"}, Dest: &jsonTestOrder{}},
+		"overflow":          {Src: map[string]interface{}{"qty": Number("70000")}, Dest: &jsonTestItem{}},
+		"fraction into int": {Src: Number("1.5"), Dest: new(int)},
+		"array into struct": {Src: []interface{}{}, Dest: &jsonTestItem{}},
+		"not a pointer":     {Src: "x", Dest: ""},
+	} {
+		if err := jsonDecode(tc.Src, tc.Dest); err == nil {
+			t.Errorf("%s: no error", name)
+		} else {
+			t.Logf("%s: %v", name, err)
+		}
+	}
+}
+
+type jsonTestA struct {
+	Name string
+	Both string
+}
+
+type jsonTestB struct {
+	Name string `json:"Name"`
+	Both string
+}
+
+func TestJSONStructFieldsDominance(t *testing.T) {
+	type outer struct {
+		jsonTestA
+		jsonTestB
+		Top string `json:"top"`
+	}
+	var got []string
+	for _, f := range jsonStructFields(reflect.TypeOf(outer{})) {
+		got = append(got, f.Name)
+	}
+	// Name: the tagged B.Name wins; Both is ambiguous, so dropped.
+	if want := []string{"Name", "top"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+	jv, err := JSONMarshal(outer{jsonTestA: jsonTestA{Name: "a"}, jsonTestB: jsonTestB{Name: "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := jv.Value.(map[string]interface{}); m["Name"] != "b" || len(m) != 2 {
+		t.Errorf("got %v", m)
+	}
+}
+
+func TestJSONDecodeBigInt(t *testing.T) {
+	const big = "1234567890123456789"
+	var i int64
+	if err := jsonDecode(Number(big), &i); err != nil {
+		t.Fatal(err)
+	} else if i != 1234567890123456789 {
+		t.Errorf("got %d, wanted %s", i, big)
+	}
+	// as JSONObject.GetValue returns it from a JSONOptDefault tree
+	if err := jsonDecode(float64(1234567890123456789), &i); err == nil {
+		t.Errorf("float64 %s: got %d, wanted error", big, i)
+	}
+	var u uint64
+	if err := jsonDecode(float64(1<<53), &u); err != nil || u != 1<<53 {
+		t.Errorf("float64 2^53: got %d, %v", u, err)
+	}
+}
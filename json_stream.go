@@ -0,0 +1,376 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+/*
+#include "dpiImpl.h"
+*/
+import "C"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"unsafe"
+)
+
+// JSONDecoder reads the tokens of a JSON document one by one, like encoding/json.Decoder.Token,
+// so a few fields can be extracted from a huge document without materializing it
+// as map[string]interface{} and []interface{} trees.
+//
+// The tokens are
+//
+//	json.Delim, for the start and end of objects ('{', '}') and arrays ('[', ']')
+//	string, for the object keys
+//
+// and the scalar values, as JSON.GetValue returns them, including Oracle's extended types:
+//
+//	string, bool, nil
+//	godror.Number (JSONOptNumberAsString) or float64 (JSONOptDefault) for NUMBER
+//	float64 for BINARY_DOUBLE, float32 for BINARY_FLOAT
+//	[]byte, for RAW
+//	time.Time, for DATE and TIMESTAMP
+//	time.Duration, for INTERVAL DAY TO SECOND
+//	IntervalYM, for INTERVAL YEAR TO MONTH
+//	Vector, for VECTOR
+type JSONDecoder struct {
+	root    jsonStreamNode
+	stack   []jsonStreamFrame
+	started bool
+}
+
+type jsonStreamFrame struct {
+	node     jsonStreamNode
+	pending  jsonStreamNode // the value of the key returned last
+	key      string
+	n, i     int
+	isObject bool
+	keyDone  bool
+}
+
+// jsonStreamNode is a node of a JSON document, either a dpiJsonNode or a node in an OSON image.
+type jsonStreamNode interface {
+	// container returns the number of children and whether it is an object; ok is false for scalars.
+	container() (n int, isObject, ok bool, err error)
+	// child returns the i-th child (and its key, for objects).
+	child(i int) (string, jsonStreamNode, error)
+	// scalar returns the value of a scalar node.
+	scalar() (interface{}, error)
+	// value materializes the node, as JSON.GetValue would.
+	value() (interface{}, error)
+}
+
+// ErrNoMoreValues is returned by JSONDecoder.Skip and Decode at the end of an object, array or document.
+var ErrNoMoreValues = errors.New("no more values")
+
+// NewDecoder returns a JSONDecoder that reads the tokens of the document.
+//
+// The whole document tree is materialized by the Oracle client library (dpiJson_getValue),
+// but the Go values are created only for the tokens read (or values decoded).
+// Use NewOSONDecoder to decode an OSON image incrementally.
+// The JSON must not be used after the rows it was fetched from are closed.
+func (j JSON) NewDecoder(opts JSONOption) (*JSONDecoder, error) {
+	var node *C.dpiJsonNode
+	if C.dpiJson_getValue(j.dpiJson, C.uint32_t(opts), (**C.dpiJsonNode)(unsafe.Pointer(&node))) == C.DPI_FAILURE {
+		return nil, ErrInvalidJSON
+	}
+	return &JSONDecoder{root: jsonCNode{node: node}}, nil
+}
+
+// NewOSONDecoder returns a JSONDecoder that reads the tokens of the OSON image in b (see DecodeOSON),
+// decoding only what is read.
+func NewOSONDecoder(b []byte, opts JSONOption) (*JSONDecoder, error) {
+	d := &osonDecoder{opts: opts}
+	if err := d.init(b); err != nil {
+		return nil, err
+	}
+	return &JSONDecoder{root: &osonStreamNode{d: d}}, nil
+}
+
+// Token returns the next token, or io.EOF at the end of the document.
+func (d *JSONDecoder) Token() (json.Token, error) {
+	if !d.started {
+		d.started = true
+		return d.open(d.root)
+	}
+	if len(d.stack) == 0 {
+		return nil, io.EOF
+	}
+	f := &d.stack[len(d.stack)-1]
+	if f.i == f.n && !f.keyDone {
+		d.stack = d.stack[:len(d.stack)-1]
+		if f.isObject {
+			return json.Delim('}'), nil
+		}
+		return json.Delim(']'), nil
+	}
+	if f.isObject && !f.keyDone {
+		key, child, err := f.node.child(f.i)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Path(), err)
+		}
+		f.key, f.pending, f.keyDone = key, child, true
+		return key, nil
+	}
+	child, err := d.next(f)
+	if err != nil {
+		return nil, err
+	}
+	return d.open(child)
+}
+
+// next returns the next value of the frame, stepping over it.
+func (d *JSONDecoder) next(f *jsonStreamFrame) (jsonStreamNode, error) {
+	if f.isObject && !f.keyDone {
+		key, child, err := f.node.child(f.i)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Path(), err)
+		}
+		f.key, f.pending = key, child
+	}
+	child := f.pending
+	if !f.isObject {
+		var err error
+		if _, child, err = f.node.child(f.i); err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", d.Path(), f.i, err)
+		}
+	}
+	f.i++
+	f.pending, f.keyDone = nil, false
+	return child, nil
+}
+
+// open returns the scalar value of the node, or the start delimiter of the container (pushing it on the stack).
+func (d *JSONDecoder) open(node jsonStreamNode) (json.Token, error) {
+	n, isObject, ok, err := node.container()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", d.Path(), err)
+	}
+	if !ok {
+		v, err := node.scalar()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Path(), err)
+		}
+		return v, nil
+	}
+	d.stack = append(d.stack, jsonStreamFrame{node: node, n: n, isObject: isObject})
+	if isObject {
+		return json.Delim('{'), nil
+	}
+	return json.Delim('['), nil
+}
+
+// More reports whether there is another element in the current array or object.
+func (d *JSONDecoder) More() bool {
+	if !d.started {
+		return true
+	}
+	if len(d.stack) == 0 {
+		return false
+	}
+	f := d.stack[len(d.stack)-1]
+	return f.i < f.n
+}
+
+// Skip skips the next value - the whole key-value pair, when the next token would be a key -
+// without decoding it.
+func (d *JSONDecoder) Skip() error {
+	_, err := d.skip()
+	return err
+}
+
+func (d *JSONDecoder) skip() (jsonStreamNode, error) {
+	if !d.started {
+		d.started = true
+		return d.root, nil
+	}
+	if len(d.stack) == 0 {
+		return nil, ErrNoMoreValues
+	}
+	f := &d.stack[len(d.stack)-1]
+	if f.i == f.n {
+		return nil, fmt.Errorf("%s: %w", d.Path(), ErrNoMoreValues)
+	}
+	return d.next(f)
+}
+
+// Decode decodes the next value into v, as JSONUnmarshal does (materializing only that value).
+//
+// It must not be called when the next token is an object key.
+func (d *JSONDecoder) Decode(v interface{}) error {
+	if n := len(d.stack); n != 0 && d.stack[n-1].isObject && !d.stack[n-1].keyDone && d.stack[n-1].i < d.stack[n-1].n {
+		return fmt.Errorf("%s: Decode called at an object key", d.Path())
+	}
+	node, err := d.skip()
+	if err != nil {
+		return err
+	}
+	src, err := node.value()
+	if err != nil {
+		return fmt.Errorf("%s: %w", d.Path(), err)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("JSON decode needs a non-nil pointer, got %T", v)
+	}
+	// The errors have the path of the value within the document.
+	return jsonDecodeValue(d.path(), src, rv.Elem())
+}
+
+// Path returns the path of the token returned last (or the value decoded or skipped last),
+// such as $.items[3].sku.
+func (d *JSONDecoder) Path() string { return jsonPath(d.path()) }
+
+// path returns the path without the leading $.
+func (d *JSONDecoder) path() string {
+	var buf bytes.Buffer
+	for _, f := range d.stack {
+		switch {
+		case f.isObject && (f.keyDone || f.i > 0):
+			buf.WriteByte('.')
+			buf.WriteString(f.key)
+		case !f.isObject && f.i > 0:
+			buf.WriteByte('[')
+			buf.WriteString(strconv.Itoa(f.i - 1))
+			buf.WriteByte(']')
+		}
+	}
+	return buf.String()
+}
+
+// jsonStreamValue materializes the node, using container, child and scalar.
+func jsonStreamValue(node jsonStreamNode) (interface{}, error) {
+	n, isObject, ok, err := node.container()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return node.scalar()
+	}
+	var m map[string]interface{}
+	var arr []interface{}
+	if isObject {
+		m = make(map[string]interface{}, n)
+	} else {
+		arr = make([]interface{}, n)
+	}
+	for i := 0; i < n; i++ {
+		key, child, err := node.child(i)
+		if err != nil {
+			return nil, err
+		}
+		v, err := jsonStreamValue(child)
+		if err != nil {
+			return nil, err
+		}
+		if isObject {
+			m[key] = v
+		} else {
+			arr[i] = v
+		}
+	}
+	if isObject {
+		return m, nil
+	}
+	return arr, nil
+}
+
+// jsonCNode is a dpiJsonNode, as returned by dpiJson_getValue.
+type jsonCNode struct {
+	node *C.dpiJsonNode
+}
+
+func (n jsonCNode) data() Data {
+	var d Data
+	jsonNodeToData(&d, n.node)
+	return d
+}
+
+func (n jsonCNode) container() (int, bool, bool, error) {
+	if n.node.value == nil {
+		return 0, false, false, nil
+	}
+	switch n.node.nativeTypeNum {
+	case C.DPI_NATIVE_TYPE_JSON_OBJECT:
+		d := n.data()
+		return int(C.dpiData_getJsonObject(&d.dpiData).numFields), true, true, nil
+	case C.DPI_NATIVE_TYPE_JSON_ARRAY:
+		d := n.data()
+		return int(C.dpiData_getJsonArray(&d.dpiData).numElements), false, true, nil
+	}
+	return 0, false, false, nil
+}
+
+func (n jsonCNode) child(i int) (string, jsonStreamNode, error) {
+	d := n.data()
+	if n.node.nativeTypeNum == C.DPI_NATIVE_TYPE_JSON_ARRAY {
+		arr := C.dpiData_getJsonArray(&d.dpiData)
+		return "", jsonCNode{node: &jsonArraySlice(arr)[i]}, nil
+	}
+	obj := C.dpiData_getJsonObject(&d.dpiData)
+	k := int(obj.numFields)
+	name := unsafe.Slice(obj.fieldNames, k)[i]
+	nameLength := unsafe.Slice(obj.fieldNameLengths, k)[i]
+	return C.GoStringN(name, C.int(nameLength)), jsonCNode{node: &unsafe.Slice(obj.fields, k)[i]}, nil
+}
+
+func (n jsonCNode) scalar() (interface{}, error) {
+	d := n.data()
+	if d.IsNull() {
+		return nil, nil
+	}
+	switch n.node.oracleTypeNum {
+	case C.DPI_ORACLE_TYPE_NUMBER:
+		return getJSONScalarNumber(d), nil
+	case C.DPI_ORACLE_TYPE_VARCHAR:
+		return string(d.GetBytes()), nil
+	case C.DPI_ORACLE_TYPE_VECTOR:
+		return decodeVectorImage(d.GetBytes())
+	}
+	v := d.Get()
+	if b, ok := v.([]byte); ok {
+		// do not keep a reference to C memory
+		v = bytes.Clone(b)
+	}
+	return v, nil
+}
+
+func (n jsonCNode) value() (interface{}, error) { return jsonStreamValue(n) }
+
+// osonStreamNode is a node in an OSON image.
+type osonStreamNode struct {
+	d          *osonDecoder
+	c          osonContainer
+	pos, depth int
+}
+
+func (n *osonStreamNode) container() (int, bool, bool, error) {
+	r := osonReader{b: n.d.tree, pos: n.pos}
+	typ := r.u8()
+	if r.err != nil {
+		return 0, false, false, r.err
+	}
+	if typ&0x80 == 0 {
+		return 0, false, false, nil
+	}
+	if err := n.d.enter(n.depth); err != nil {
+		return 0, false, false, err
+	}
+	var err error
+	n.c, err = n.d.containerHeader(&r, typ, n.pos)
+	return n.c.num, n.c.isObject, true, err
+}
+
+func (n *osonStreamNode) child(i int) (string, jsonStreamNode, error) {
+	name, pos, err := n.d.child(n.c, i)
+	return name, &osonStreamNode{d: n.d, pos: pos, depth: n.depth + 1}, err
+}
+
+func (n *osonStreamNode) scalar() (interface{}, error) { return n.d.node(n.pos, n.depth) }
+func (n *osonStreamNode) value() (interface{}, error)  { return n.d.node(n.pos, n.depth) }
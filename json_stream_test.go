@@ -0,0 +1,154 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJSONDecoderTokens(t *testing.T) {
+	ts := time.Date(2026, 10, 18, 1, 2, 3, 0, time.Local)
+	img, err := EncodeOSON(map[string]interface{}{
+		"a": []interface{}{Number("1"), "x", nil, map[string]interface{}{}},
+		"b": map[string]interface{}{"t": ts, "d": time.Second, "r": []byte{1}, "v": Vector{Values: []float32{1, 2}, Dimensions: 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := NewOSONDecoder(img, JSONOptNumberAsString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type tokPath struct {
+		Token json.Token
+		Path  string
+	}
+	var got []tokPath
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, tokPath{Token: tok, Path: dec.Path()})
+	}
+	want := []tokPath{
+		{json.Delim('{'), "$"},
+		{"a", "$.a"}, {json.Delim('['), "$.a"},
+		{Number("1"), "$.a[0]"}, {"x", "$.a[1]"}, {nil, "$.a[2]"},
+		{json.Delim('{'), "$.a[3]"}, {json.Delim('}'), "$.a[3]"},
+		{json.Delim(']'), "$.a"},
+		{"b", "$.b"}, {json.Delim('{'), "$.b"},
+		{"d", "$.b.d"}, {time.Second, "$.b.d"},
+		{"r", "$.b.r"}, {[]byte{1}, "$.b.r"},
+		{"t", "$.b.t"}, {ts, "$.b.t"},
+		{"v", "$.b.v"}, {Vector{Values: []float32{1, 2}, Dimensions: 2}, "$.b.v"},
+		{json.Delim('}'), "$.b"},
+		{json.Delim('}'), "$"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got\n%v\nwanted\n%v", got, want)
+	}
+	if dec.More() {
+		t.Error("More at the end")
+	}
+}
+
+func TestJSONDecoderExtract(t *testing.T) {
+	doc := osonTestDoc()
+	img, err := EncodeOSON(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := NewOSONDecoder(img, JSONOptNumberAsString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		t.Fatalf("got %v, %v", tok, err)
+	}
+	var id, decodeErrs int
+	var skus []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch tok {
+		case "id":
+			if err = dec.Decode(&id); err != nil {
+				t.Fatal(err)
+			}
+		case "items":
+			if _, err = dec.Token(); err != nil { // [
+				t.Fatal(err)
+			}
+			for dec.More() {
+				var item struct {
+					SKU string `json:"sku"`
+				}
+				if err = dec.Decode(&item); err != nil {
+					// the path is in the error only once
+					if want := "$.items[2]: "; !strings.HasPrefix(err.Error(), want) || strings.Count(err.Error(), "$") != 1 {
+						t.Errorf("got %q, wanted it to start with %q", err, want)
+					}
+					decodeErrs++
+					continue
+				}
+				skus = append(skus, item.SKU)
+			}
+			if tok, err = dec.Token(); err != nil || tok != json.Delim(']') {
+				t.Fatalf("got %v, %v", tok, err)
+			}
+		default:
+			if err = dec.Skip(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if id != 42 || !reflect.DeepEqual(skus, []string{"a-1", "b-2", ""}) || decodeErrs != 1 {
+		t.Errorf("got id=%d skus=%q decodeErrs=%d", id, skus, decodeErrs)
+	}
+	if err = dec.Skip(); err == nil {
+		t.Error("Skip at the end of the object succeeded")
+	}
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('}') {
+		t.Fatalf("got %v, %v", tok, err)
+	}
+	if _, err = dec.Token(); !errors.Is(err, io.EOF) {
+		t.Errorf("got %v, wanted EOF", err)
+	}
+
+	// Skip whole members, and Decode the rest
+	if dec, err = NewOSONDecoder(img, JSONOptNumberAsString); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dec.Token(); err != nil {
+		t.Fatal(err)
+	}
+	if err = dec.Decode(new(interface{})); err == nil {
+		t.Error("Decode at a key succeeded")
+	}
+	if err = dec.Skip(); err != nil {
+		t.Fatal(err)
+	}
+	var rest interface{}
+	if dec, err = NewOSONDecoder(img, JSONOptNumberAsString); err != nil {
+		t.Fatal(err)
+	}
+	if err = dec.Decode(&rest); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(rest, doc) {
+		t.Errorf("got %v, wanted %v", rest, doc)
+	}
+}
@@ -83,6 +83,46 @@ func (lob *Lob) ReadAt(p []byte, off int64) (int, error) {
 	return 0, ErrNotSupported
 }
 
+// CharSize exposes the underlying Reader's CharSize method, if it is supported.
+func (lob *Lob) CharSize() (int64, error) {
+	if lr, ok := lob.Reader.(interface{ CharSize() (int64, error) }); ok {
+		return lr.CharSize()
+	}
+	return 0, ErrNotSupported
+}
+
+// Seek exposes the underlying Reader's Seek method, if it is supported.
+//
+// For CLOBs, the offset is in characters (UCS-2 code units).
+func (lob *Lob) Seek(offset int64, whence int) (int64, error) {
+	if lr, ok := lob.Reader.(io.Seeker); ok {
+		return lr.Seek(offset, whence)
+	}
+	return 0, ErrNotSupported
+}
+
+// ReadRune exposes the underlying Reader's ReadRune method, if it is supported.
+func (lob *Lob) ReadRune() (rune, int, error) {
+	if lr, ok := lob.Reader.(io.RuneReader); ok {
+		return lr.ReadRune()
+	}
+	return 0, 0, ErrNotSupported
+}
+
+// Close closes the underlying Reader, if it is an io.Closer.
+//
+// A Lob read to EOF is closed automatically, but after a Seek or ReadAt it is kept open,
+// so it must be Closed explicitly.
+func (lob *Lob) Close() error {
+	if lob == nil {
+		return nil
+	}
+	if c, ok := lob.Reader.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
 // Scan assigns a value from a database driver.
 //
 // The src value will be of one of the following types:
@@ -108,6 +148,8 @@ func (dlr *dpiLobReader) Scan(src interface{}) error {
 
 var _ = io.ReadCloser((*dpiLobReader)(nil))
 var _ = io.ReaderAt((*dpiLobReader)(nil))
+var _ = io.Seeker((*dpiLobReader)(nil))
+var _ = io.RuneReader((*dpiLobReader)(nil))
 
 type dpiLobReader struct {
 	*drv
@@ -119,6 +161,8 @@ type dpiLobReader struct {
 	bufR, bufW          int
 	finished            bool
 	IsClob              bool
+	// keepOpen is set by Seek and ReadAt, to not close the LOB on EOF.
+	keepOpen bool
 }
 
 // WriteTo writes data to w until there's no more data to write or when an error occurs.
@@ -178,7 +222,9 @@ func (dlr *dpiLobReader) Read(p []byte) (int, error) {
 			}
 		}
 		// If the dest buffer is big enough, avoid copying.
-		if ulen := C.uint64_t(len(p)); ulen >= C.uint64_t(dlr.chunkSize) || dlr.sizePlusOne != 0 && ulen+1 >= dlr.sizePlusOne {
+		// A CLOB needs room for at least one whole character, though.
+		if ulen := C.uint64_t(len(p)); ulen >= C.uint64_t(dlr.chunkSize) ||
+			dlr.sizePlusOne != 0 && ulen+1 >= dlr.sizePlusOne && (!dlr.IsClob || ulen >= utf8.UTFMax) {
 			if logger != nil {
 				logger.Debug("direct read", "p", len(p), "chunkSize", dlr.chunkSize)
 			}
@@ -217,10 +263,13 @@ func (dlr *dpiLobReader) Read(p []byte) (int, error) {
 var ErrCLOB = errors.New("CLOB is not supported")
 
 // Size returns the LOB's size. It returns ErrCLOB for CLOB,
-// (works only for BLOBs), as Oracle reports CLOB size in runes, not in bytes!
+// (works only for BLOBs), as Oracle reports CLOB size in characters, not in bytes!
+// Use CharSize for CLOBs.
 func (dlr *dpiLobReader) Size() (int64, error) {
 	dlr.mu.Lock()
-	err := dlr.getSize()
+	runtime.LockOSThread()
+	err := dlr.init()
+	runtime.UnlockOSThread()
 	size := dlr.sizePlusOne - 1
 	isClob := dlr.IsClob
 	dlr.mu.Unlock()
@@ -229,6 +278,42 @@ func (dlr *dpiLobReader) Size() (int64, error) {
 	}
 	return int64(size), err
 }
+
+// CharSize returns the LOB's size in characters (UCS-2 code units) for CLOBs,
+// in bytes for BLOBs.
+func (dlr *dpiLobReader) CharSize() (int64, error) {
+	dlr.mu.Lock()
+	defer dlr.mu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := dlr.init(); err != nil {
+		return 0, err
+	}
+	return int64(dlr.sizePlusOne - 1), nil
+}
+
+// init reads the size and the type of the LOB, if not read yet.
+// Must be called with the OS thread locked.
+func (dlr *dpiLobReader) init() error {
+	if dlr.sizePlusOne != 0 {
+		return nil
+	}
+	if dlr.dpiLob == nil {
+		return io.EOF
+	}
+	if err := dlr.getSize(); err != nil {
+		return err
+	}
+	var lobType C.dpiOracleTypeNum
+	if err := dlr.checkExecNoLOT(func() C.int {
+		return C.dpiLob_getType(dlr.dpiLob, &lobType)
+	}); err != nil {
+		return err
+	}
+	dlr.IsClob = lobType == C.DPI_ORACLE_TYPE_CLOB || lobType == C.DPI_ORACLE_TYPE_NCLOB
+	return nil
+}
+
 func (dlr *dpiLobReader) getSize() error {
 	if dlr.sizePlusOne != 0 {
 		return nil
@@ -264,129 +349,267 @@ func (dlr *dpiLobReader) read(p []byte) (int, error) {
 	}
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
-	// For CLOB, sizePlusOne and offset counts the CHARACTERS!
+	// For CLOB, sizePlusOne and offset counts the CHARACTERS (UCS-2 code units)!
 	// See https://oracle.github.io/odpi/doc/public_functions/dpiLob.html dpiLob_readBytes
-	var lobType C.dpiOracleTypeNum
-	if dlr.sizePlusOne == 0 { // first read
-		// never read size before
-		if err := dlr.getSize(); err != nil {
-			var coder interface{ Code() int }
-			if errors.As(err, &coder) && coder.Code() == 22922 || strings.Contains(err.Error(), "invalid dpiLob handle") {
-				return 0, io.EOF
-			}
-			return 0, err
-		}
-
-		dlr.IsClob = false
-		if err := dlr.checkExecNoLOT(func() C.int {
-			return C.dpiLob_getType(dlr.dpiLob, &lobType)
-		}); err != nil {
-			return 0, err
-		} else if lobType == C.DPI_ORACLE_TYPE_CLOB || lobType == C.DPI_ORACLE_TYPE_NCLOB {
-			dlr.IsClob = true
+	if err := dlr.init(); err != nil {
+		var coder interface{ Code() int }
+		if errors.As(err, &coder) && coder.Code() == 22922 || strings.Contains(err.Error(), "invalid dpiLob handle") {
+			return 0, io.EOF
 		}
+		return 0, err
 	}
-	n := C.uint64_t(len(p))
-	amount := n
-	if dlr.IsClob {
-		amount /= 4 // dpiLob_readBytes' amount is the number of CHARACTERS for CLOBs.
-	}
-	if logger != nil {
-		logger.Debug("Read", "offset", dlr.offset, "sizePlusOne", dlr.sizePlusOne, "n", n, "amount", amount, "lobType", lobType)
-	}
-	if !dlr.IsClob && dlr.offset+1 >= dlr.sizePlusOne {
+	if dlr.offset+1 >= dlr.sizePlusOne {
 		if logger != nil {
 			logger.Debug("LOB reached end", "offset", dlr.offset, "size", dlr.sizePlusOne)
 		}
+		dlr.finish()
 		return 0, io.EOF
 	}
-	rd := func() error {
-		return dlr.drv.checkExecNoLOT(func() C.int {
-			return C.dpiLob_readBytes(dlr.dpiLob, dlr.offset+1, amount, (*C.char)(unsafe.Pointer(&p[0])), &n)
+	var n, units C.uint64_t
+	var err error
+	if dlr.IsClob {
+		n, units, err = readClobAt(dlr.drv, dlr.dpiLob, p, dlr.offset)
+	} else {
+		n = C.uint64_t(len(p))
+		err = dlr.drv.checkExecNoLOT(func() C.int {
+			return C.dpiLob_readBytes(dlr.dpiLob, dlr.offset+1, n, (*C.char)(unsafe.Pointer(&p[0])), &n)
 		})
+		units = n
 	}
-	if err := rd(); err != nil {
+	if err != nil {
 		var codeErr interface{ Code() int }
-		errors.As(err, &codeErr)
-		if logger != nil {
-			logger.Warn("readBytes", "offset", dlr.offset, "amount", amount, "error", err)
+		if dlr.finished = errors.As(err, &codeErr) && codeErr.Code() == 1403; dlr.finished {
+			dlr.offset += units
+			dlr.finish()
+			return int(n), io.EOF
 		}
-		if dlr.IsClob && codeErr != nil && codeErr.Code() == 22831 {
-			amount--
-			if err = rd(); err != nil {
-				logger.Warn("readBytes", "offset", dlr.offset, "amount", amount, "error", err)
-				dlr.offset--
-				err = rd()
-			}
-		}
-		if err != nil {
+		if !dlr.keepOpen {
 			C.dpiLob_close(dlr.dpiLob)
 			dlr.dpiLob = nil
-			if dlr.finished = codeErr != nil && codeErr.Code() == 1403; dlr.finished {
-				dlr.offset += n
-				return int(n), io.EOF
-			}
-			return int(n), fmt.Errorf("dpiLob_readbytes(lob=%p offset=%d n=%d amount=%d): %w", dlr.dpiLob, dlr.offset, len(p), amount, err)
 		}
+		return int(n), fmt.Errorf("dpiLob_readbytes(lob=%p offset=%d n=%d): %w", dlr.dpiLob, dlr.offset, len(p), err)
+	}
+	dlr.offset += units
+	if n == 0 || dlr.offset+1 >= dlr.sizePlusOne {
+		dlr.finish()
+		err = io.EOF
 	}
 	if logger != nil {
-		logger.Debug("read", "n", n, "amount", amount, "offset", dlr.offset)
+		logger.Debug("LOB", "n", n, "offset", dlr.offset, "size", dlr.sizePlusOne, "finished", dlr.finished, "clob", dlr.IsClob, "error", err)
 	}
-	if !dlr.IsClob {
-		dlr.offset += n
-	} else {
-		// trim last erroneous encodings
-		for {
-			r, size := utf8.DecodeLastRune(p[:n])
-			if size == 0 || r != utf8.RuneError {
-				break
-			}
+	return int(n), err
+}
+
+// finish marks the reader as finished, and closes the LOB, if not kept open.
+func (dlr *dpiLobReader) finish() {
+	dlr.finished = true
+	if !dlr.keepOpen && dlr.dpiLob != nil {
+		C.dpiLob_close(dlr.dpiLob)
+		dlr.dpiLob = nil
+	}
+}
+
+// readClobAt reads whole characters from the CLOB starting at the given (0-based) character offset into p,
+// returning the number of bytes read, and the number of characters (UCS-2 code units) they represent.
+//
+// It never splits a surrogate pair: if the amount would end in the middle of one,
+// it reads one character less (ORA-22831).
+//
+// Must be called with the OS thread locked.
+func readClobAt(d *drv, lob *C.dpiLob, p []byte, offset C.uint64_t) (n, units C.uint64_t, err error) {
+	logger := getLogger(context.TODO())
+	// dpiLob_readBytes' amount is the number of CHARACTERS for CLOBs,
+	// and one character needs at most 4 bytes in UTF-8.
+	amount := C.uint64_t(len(p) / 4)
+	if amount == 0 {
+		return 0, 0, io.ErrShortBuffer
+	}
+	rd := func() error {
+		n = C.uint64_t(len(p))
+		return d.checkExecNoLOT(func() C.int {
+			return C.dpiLob_readBytes(lob, offset+1, amount, (*C.char)(unsafe.Pointer(&p[0])), &n)
+		})
+	}
+	if err = rd(); err != nil {
+		var codeErr interface{ Code() int }
+		if errors.As(err, &codeErr) && codeErr.Code() == 22831 && amount > 1 {
+			// the amount ends in the middle of a surrogate pair
 			if logger != nil {
-				logger.Warn("LastRune", "r", r, "size", size, "n", n)
+				logger.Warn("readBytes", "offset", offset, "amount", amount, "error", err)
 			}
-			n--
+			amount--
+			err = rd()
 		}
-		if !dlr.IsClob {
-			dlr.offset += C.uint64_t(utf8.RuneCount(p[:n]))
-		} else {
-			// NCLOB must be count in UTF-16
-			for i, n := 0, int(n); i < n; {
-				r, size := utf8.DecodeRune(p[i:n])
-				i += size
-				dlr.offset += C.uint64_t(utf16.RuneLen(r))
-			}
+		if err != nil {
+			return 0, 0, err
 		}
 	}
-	var err error
-	if amount != 0 && n == 0 || !dlr.IsClob && dlr.offset+1 >= dlr.sizePlusOne {
-		C.dpiLob_close(dlr.dpiLob)
-		dlr.dpiLob = nil
-		dlr.finished = true
-		err = io.EOF
+	// trim the last incomplete encoding, if any
+	m := trimIncompleteUTF8(p[:n])
+	if logger != nil && m != int(n) {
+		logger.Warn("trimmed incomplete UTF-8", "n", n, "m", m)
 	}
-	if logger != nil {
-		logger.Debug("LOB", "n", n, "offset", dlr.offset, "size", dlr.sizePlusOne, "finished", dlr.finished, "clob", dlr.IsClob, "error", err)
+	return C.uint64_t(m), C.uint64_t(utf16Len(p[:m])), nil
+}
+
+// trimIncompleteUTF8 returns the length of p without the trailing incomplete UTF-8 sequence.
+func trimIncompleteUTF8(p []byte) int {
+	n := len(p)
+	// a rune is at most utf8.UTFMax bytes long, so look back at most that much for its start.
+	for i := n - 1; i >= 0 && i >= n-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if !utf8.FullRune(p[i:n]) {
+				return i
+			}
+			break
+		}
 	}
-	return int(n), err
+	return n
+}
+
+// utf16Len returns the number of UTF-16 code units p (UTF-8) would need - that is how Oracle counts CLOB characters.
+func utf16Len(p []byte) int64 {
+	var n int64
+	for i := 0; i < len(p); {
+		r, size := utf8.DecodeRune(p[i:])
+		i += size
+		if k := utf16.RuneLen(r); k > 0 {
+			n += int64(k)
+		} else {
+			n++
+		}
+	}
+	return n
 }
 
-// ReadAt reads at the specified offset (in bytes).
-// Works only for BLOBs!
+// ReadAt reads at the specified offset: in bytes for BLOBs, in characters (UCS-2 code units) for CLOBs.
+//
+// For CLOBs, only whole characters are read, so n may be less than len(p) even without an error -
+// except when p is shorter than the character at off: then its first len(p) bytes are returned.
+//
+// ReadAt keeps the LOB open at EOF, so the reader must be Closed explicitly.
 func (dlr *dpiLobReader) ReadAt(p []byte, off int64) (int, error) {
 	dlr.mu.Lock()
 	defer dlr.mu.Unlock()
+	if len(p) == 0 {
+		return 0, nil
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := dlr.init(); err != nil {
+		return 0, err
+	}
+	if dlr.dpiLob == nil {
+		return 0, errors.New("LOB is closed")
+	}
+	dlr.keepOpen = true
+	if C.uint64_t(off)+1 >= dlr.sizePlusOne {
+		return 0, io.EOF
+	}
 	if dlr.IsClob {
-		return 0, ErrCLOB
+		dst := p
+		var scratch [utf8.UTFMax]byte
+		if len(p) < len(scratch) {
+			// Read one character, and return as much of it as fits.
+			dst = scratch[:]
+		}
+		n, _, err := readClobAt(dlr.drv, dlr.dpiLob, dst, C.uint64_t(off))
+		if err != nil {
+			return 0, fmt.Errorf("readBytes at %d for %d: %w", off, len(p), err)
+		}
+		if len(p) < len(scratch) {
+			n = C.uint64_t(copy(p, scratch[:n]))
+		}
+		return int(n), nil
 	}
 	n := C.uint64_t(len(p))
-	err := dlr.checkExec(func() C.int {
+	err := dlr.checkExecNoLOT(func() C.int {
 		return C.dpiLob_readBytes(dlr.dpiLob, C.uint64_t(off+1), n, (*C.char)(unsafe.Pointer(&p[0])), &n)
 	})
 	if err != nil {
-		err = fmt.Errorf("readBytes at %d for %d: %w", off, n, dlr.getError())
+		err = fmt.Errorf("readBytes at %d for %d: %w", off, n, err)
+	} else if int(n) < len(p) {
+		err = io.EOF
 	}
 	return int(n), err
 }
+
+// Seek sets the offset for the next Read: in bytes for BLOBs, in characters (UCS-2 code units) for CLOBs.
+//
+// Seek must be called before the reader reaches EOF (which closes the LOB),
+// and after a Seek, the LOB is kept open at EOF, so the reader must be Closed explicitly.
+func (dlr *dpiLobReader) Seek(offset int64, whence int) (int64, error) {
+	dlr.mu.Lock()
+	defer dlr.mu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := dlr.init(); err != nil {
+		return 0, err
+	}
+	if dlr.dpiLob == nil {
+		return 0, errors.New("LOB is closed")
+	}
+	dlr.keepOpen = true
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = dlr.position() + offset
+	case io.SeekEnd:
+		abs = int64(dlr.sizePlusOne-1) + offset
+	default:
+		return 0, fmt.Errorf("seek: invalid whence %d", whence)
+	}
+	if abs < 0 {
+		return 0, fmt.Errorf("seek: negative position %d", abs)
+	}
+	dlr.offset = C.uint64_t(abs)
+	dlr.bufR, dlr.bufW = 0, 0
+	dlr.finished = false
+	return abs, nil
+}
+
+// position returns the logical position of the reader, subtracting the not yet consumed buffer.
+func (dlr *dpiLobReader) position() int64 {
+	pos := int64(dlr.offset)
+	if dlr.bufR < dlr.bufW {
+		if dlr.IsClob {
+			pos -= utf16Len(dlr.buf[dlr.bufR:dlr.bufW])
+		} else {
+			pos -= int64(dlr.bufW - dlr.bufR)
+		}
+	}
+	return pos
+}
+
+// ReadRune reads one character from the (CLOB) LOB.
+func (dlr *dpiLobReader) ReadRune() (rune, int, error) {
+	dlr.mu.Lock()
+	defer dlr.mu.Unlock()
+	if dlr.bufR >= dlr.bufW {
+		if dlr.buf == nil {
+			dlr.buf = make([]byte, 1<<16)
+		}
+		var err error
+		dlr.bufR = 0
+		// nosemgrep: trailofbits.go.questionable-assignment.questionable-assignment
+		dlr.bufW, err = dlr.read(dlr.buf)
+		if dlr.bufW == 0 {
+			if err == nil {
+				err = io.EOF
+			}
+			return 0, 0, err
+		}
+	}
+	r, size := utf8.DecodeRune(dlr.buf[dlr.bufR:dlr.bufW])
+	dlr.bufR += size
+	if dlr.bufR == dlr.bufW {
+		dlr.bufR, dlr.bufW = 0, 0
+	}
+	return r, size, nil
+}
+
 func (dlr *dpiLobReader) Close() error {
 	if dlr == nil || dlr.dpiLob == nil {
 		return nil
@@ -399,12 +622,51 @@ func (dlr *dpiLobReader) Close() error {
 type dpiLobWriter struct {
 	*drv
 	dpiLob *C.dpiLob
-	offset C.uint64_t
-	opened bool
-	isClob bool
+	// partial holds the incomplete UTF-8 sequence at the end of the last write (CLOB only).
+	partial []byte
+	offset  C.uint64_t
+	opened  bool
+	isClob  bool
 }
 
 func (dlw *dpiLobWriter) Write(p []byte) (int, error) {
+	if !dlw.isClob {
+		return dlw.write(p)
+	}
+	// CLOB offsets are in characters (UCS-2 code units), so write whole runes only.
+	return dlw.writeRunes(p, dlw.write)
+}
+
+// writeRunes writes the whole runes of partial+p with write, and keeps the incomplete
+// UTF-8 sequence at the end in partial.
+// On error, it returns the number of bytes of p written.
+func (dlw *dpiLobWriter) writeRunes(p []byte, write func([]byte) (int, error)) (int, error) {
+	prev := len(dlw.partial)
+	if prev != 0 {
+		// A fresh buffer, as partial is reused for the tail below.
+		p = append(append(make([]byte, 0, prev+len(p)), dlw.partial...), p...)
+	}
+	k := trimIncompleteUTF8(p)
+	if k != 0 {
+		if n, err := write(p[:k]); err != nil {
+			return max(0, n-prev), err
+		}
+	}
+	dlw.partial = append(dlw.partial[:0], p[k:]...)
+	return len(p) - prev, nil
+}
+
+// flush writes the remaining incomplete UTF-8 sequence, if any.
+func (dlw *dpiLobWriter) flush() error {
+	if len(dlw.partial) == 0 {
+		return nil
+	}
+	_, err := dlw.write(dlw.partial)
+	dlw.partial = dlw.partial[:0]
+	return err
+}
+
+func (dlw *dpiLobWriter) write(p []byte) (int, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
@@ -427,7 +689,11 @@ func (dlw *dpiLobWriter) Write(p []byte) (int, error) {
 		_ = closeLob(dlw, lob)
 		return 0, err
 	}
-	dlw.offset += n
+	if dlw.isClob {
+		dlw.offset += C.uint64_t(utf16Len(p))
+	} else {
+		dlw.offset += n
+	}
 
 	return int(n), nil
 }
@@ -436,9 +702,13 @@ func (dlw *dpiLobWriter) Close() error {
 	if dlw == nil || dlw.dpiLob == nil {
 		return nil
 	}
+	flushErr := dlw.flush()
 	lob := dlw.dpiLob
 	dlw.dpiLob = nil
-	return closeLob(dlw, lob)
+	if err := closeLob(dlw, lob); err != nil {
+		return err
+	}
+	return flushErr
 }
 
 func closeLob(d interface{ getError() error }, lob *C.dpiLob) error {
@@ -466,9 +736,10 @@ func closeLob(d interface{ getError() error }, lob *C.dpiLob) error {
 
 // DirectLob holds a Lob and allows direct (Read/WriteAt, not streaming Read/Write) operations on it.
 type DirectLob struct {
-	drv            *drv
-	dpiLob         *C.dpiLob
-	opened, isClob bool
+	drv    *drv
+	dpiLob *C.dpiLob
+	// owned is set for the LOBs created by us (NewTempLob, Copy, Substr), to be released on Close.
+	opened, isClob, owned bool
 }
 
 var _ = io.ReaderAt((*DirectLob)(nil))
@@ -480,7 +751,7 @@ func (c *conn) NewTempLob(isClob bool) (*DirectLob, error) {
 	if isClob {
 		typ = C.DPI_ORACLE_TYPE_CLOB
 	}
-	lob := DirectLob{drv: c.drv, isClob: isClob}
+	lob := DirectLob{drv: c.drv, isClob: isClob, owned: true}
 	if err := c.checkExec(func() C.int { return C.dpiConn_newTempLob(c.dpiConn, typ, &lob.dpiLob) }); err != nil {
 		return nil, fmt.Errorf("newTempLob: %w", err)
 	}
@@ -489,11 +760,11 @@ func (c *conn) NewTempLob(isClob bool) (*DirectLob, error) {
 
 // Close the Lob.
 func (dl *DirectLob) Close() error {
-	if !dl.opened {
+	if !dl.opened && !dl.owned {
 		return nil
 	}
 	lob := dl.dpiLob
-	dl.opened, dl.dpiLob = false, nil
+	dl.opened, dl.owned, dl.dpiLob = false, false, nil
 	return closeLob(dl.drv, lob)
 }
 
@@ -0,0 +1,217 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+/*
+#include "dpiImpl.h"
+*/
+import "C"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// The server-side operations below use DBMS_LOB, so the data does not travel to the client.
+//
+// The Execer must be the *sql.Conn or *sql.Tx (session) the LOBs belong to,
+// as temporary LOBs are bound to the session.
+//
+// Offsets are 0-based, and both offsets and amounts are in bytes for BLOBs,
+// and characters (UCS-2 code units) for CLOBs.
+
+// IsClob reports whether the LOB is a CLOB or NCLOB.
+func (dl *DirectLob) IsClob() (bool, error) {
+	if dl.dpiLob == nil {
+		return dl.isClob, nil
+	}
+	var typ C.dpiOracleTypeNum
+	if err := dl.drv.checkExec(func() C.int { return C.dpiLob_getType(dl.dpiLob, &typ) }); err != nil {
+		return false, fmt.Errorf("getType: %w", err)
+	}
+	dl.isClob = typ == C.DPI_ORACLE_TYPE_CLOB || typ == C.DPI_ORACLE_TYPE_NCLOB
+	return dl.isClob, nil
+}
+
+// asLob returns the DirectLob as a Lob, for binding it as is.
+func (dl *DirectLob) asLob() (Lob, error) {
+	if dl == nil || dl.dpiLob == nil {
+		return Lob{}, errors.New("lob is nil")
+	}
+	isClob, err := dl.IsClob()
+	if err != nil {
+		return Lob{}, err
+	}
+	return Lob{Reader: &dpiLobReader{drv: dl.drv, dpiLob: dl.dpiLob, IsClob: isClob}, IsClob: isClob}, nil
+}
+
+// Copy returns a new locator for the LOB.
+//
+// For temporary LOBs this is a (server-side) deep copy.
+// The returned DirectLob must be Closed.
+func (dl *DirectLob) Copy() (*DirectLob, error) {
+	if dl.dpiLob == nil {
+		return nil, errors.New("lob is nil")
+	}
+	cp := DirectLob{drv: dl.drv, isClob: dl.isClob, owned: true}
+	if err := dl.drv.checkExec(func() C.int { return C.dpiLob_copy(dl.dpiLob, &cp.dpiLob) }); err != nil {
+		return nil, fmt.Errorf("copy: %w", err)
+	}
+	return &cp, nil
+}
+
+// Append the whole src to the end of the LOB.
+func (dl *DirectLob) Append(ctx context.Context, ex Execer, src *DirectLob) error {
+	dst, err := dl.asLob()
+	if err != nil {
+		return err
+	}
+	s, err := src.asLob()
+	if err != nil {
+		return err
+	}
+	const qry = "BEGIN DBMS_LOB.APPEND(:1, :2); END;"
+	if _, err := ex.ExecContext(ctx, qry, dst, s); err != nil {
+		return fmt.Errorf("%s: %w", qry, err)
+	}
+	return nil
+}
+
+// CopyFrom copies amount from src (starting at srcOffset) into the LOB at dstOffset.
+//
+// A non-positive amount means the rest of src.
+func (dl *DirectLob) CopyFrom(ctx context.Context, ex Execer, src *DirectLob, amount, dstOffset, srcOffset int64) error {
+	dst, err := dl.asLob()
+	if err != nil {
+		return err
+	}
+	s, err := src.asLob()
+	if err != nil {
+		return err
+	}
+	if amount <= 0 {
+		if amount, err = src.rest(srcOffset); err != nil || amount == 0 {
+			return err
+		}
+	}
+	const qry = "BEGIN DBMS_LOB.COPY(:1, :2, :3, :4, :5); END;"
+	if _, err := ex.ExecContext(ctx, qry, dst, s, amount, dstOffset+1, srcOffset+1); err != nil {
+		return fmt.Errorf("%s: %w", qry, err)
+	}
+	return nil
+}
+
+// Compare amount of the LOB (from offset) with other (from otherOffset),
+// returning 0 if they are the same, -1 if the LOB is less, and 1 if it is greater than other.
+//
+// A non-positive amount means the longer of the two rests.
+func (dl *DirectLob) Compare(ctx context.Context, ex Execer, other *DirectLob, amount, offset, otherOffset int64) (int, error) {
+	a, err := dl.asLob()
+	if err != nil {
+		return 0, err
+	}
+	b, err := other.asLob()
+	if err != nil {
+		return 0, err
+	}
+	if amount <= 0 {
+		if amount, err = dl.rest(offset); err != nil {
+			return 0, err
+		}
+		if m, err := other.rest(otherOffset); err != nil {
+			return 0, err
+		} else if m > amount {
+			amount = m
+		}
+		if amount == 0 {
+			return 0, nil
+		}
+	}
+	const qry = "BEGIN :1 := DBMS_LOB.COMPARE(:2, :3, :4, :5, :6); END;"
+	var res sql.NullInt64
+	if _, err := ex.ExecContext(ctx, qry, sql.Out{Dest: &res}, a, b, amount, offset+1, otherOffset+1); err != nil {
+		return 0, fmt.Errorf("%s: %w", qry, err)
+	}
+	if !res.Valid {
+		return 0, fmt.Errorf("%s: invalid offsets (%d, %d) or amount (%d)", qry, offset, otherOffset, amount)
+	}
+	switch {
+	case res.Int64 < 0:
+		return -1, nil
+	case res.Int64 > 0:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Instr returns the offset of the nth (1-based) occurrence of pattern in the LOB,
+// searching from offset, or -1 if not found.
+func (dl *DirectLob) Instr(ctx context.Context, ex Execer, pattern string, offset int64, nth int) (int64, error) {
+	L, err := dl.asLob()
+	if err != nil {
+		return -1, err
+	}
+	if nth < 1 {
+		nth = 1
+	}
+	var pat interface{} = pattern
+	if !L.IsClob {
+		pat = []byte(pattern)
+	}
+	const qry = "BEGIN :1 := DBMS_LOB.INSTR(:2, :3, :4, :5); END;"
+	var res sql.NullInt64
+	if _, err := ex.ExecContext(ctx, qry, sql.Out{Dest: &res}, L, pat, offset+1, nth); err != nil {
+		return -1, fmt.Errorf("%s: %w", qry, err)
+	}
+	if !res.Valid || res.Int64 == 0 {
+		return -1, nil
+	}
+	return res.Int64 - 1, nil
+}
+
+// Substr returns a new temporary LOB holding amount of the LOB from offset.
+//
+// A non-positive amount means the rest of the LOB.
+// The returned DirectLob must be Closed.
+func (dl *DirectLob) Substr(ctx context.Context, ex Execer, amount, offset int64) (*DirectLob, error) {
+	isClob, err := dl.IsClob()
+	if err != nil {
+		return nil, err
+	}
+	c, err := getConn(ctx, ex)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := c.NewTempLob(isClob)
+	if err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		if amount, err = dl.rest(offset); err != nil || amount == 0 {
+			if err != nil {
+				_ = tmp.Close()
+				return nil, err
+			}
+			return tmp, nil
+		}
+	}
+	if err = tmp.CopyFrom(ctx, ex, dl, amount, 0, offset); err != nil {
+		_ = tmp.Close()
+		return nil, err
+	}
+	return tmp, nil
+}
+
+// rest returns the size of the LOB after offset.
+func (dl *DirectLob) rest(offset int64) (int64, error) {
+	size, err := dl.Size()
+	if err != nil || size <= offset {
+		return 0, err
+	}
+	return size - offset, nil
+}
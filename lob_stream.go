@@ -0,0 +1,131 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+/*
+#include "dpiImpl.h"
+*/
+import "C"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// LobStream streams Reader into the LOB returned by an
+// INSERT/UPDATE ... RETURNING lob_col INTO :x statement.
+//
+// Bind it as sql.Out{Dest: &lobStream} to the RETURNING placeholder:
+// after the execution, the persistent LOB locator is written with the contents of Reader,
+// without holding it all in memory or creating a temporary LOB.
+// The LOB column should be initialized with EMPTY_BLOB() / EMPTY_CLOB().
+//
+// The row lock is needed for writing the LOB, so when not in a transaction,
+// the commit is postponed after the LOB is written (and everything is rolled back on error).
+type LobStream struct {
+	// Reader is the source of the LOB contents.
+	Reader io.Reader
+	// Progress is called after each chunk written, with the number of bytes written so far.
+	Progress func(written int64)
+	// ChunkSize is the size of one write. Defaults to the LOB's chunk size,
+	// multiplied up to at least 64KiB.
+	ChunkSize int
+	// IsClob makes the RETURNING variable a CLOB, so it must be set for CLOB columns,
+	// else the execution fails. The data is written according to the returned LOB's type.
+	IsClob bool
+	// Written is the number of bytes written.
+	Written int64
+}
+
+// hasLobStream reports whether any of the bind destinations is a LobStream.
+func hasLobStream(dests []interface{}) bool {
+	for _, d := range dests {
+		if _, ok := d.(*LobStream); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// dataGetLobStream writes the LobStream's Reader into the returned LOB.
+//
+// No returned rows means nothing to write, more than one row is an error.
+func (c *conn) dataGetLobStream(ctx context.Context, v interface{}, data []C.dpiData) error {
+	ls, ok := v.(*LobStream)
+	if !ok {
+		return fmt.Errorf("LobStream: needs *LobStream, got %T", v)
+	}
+	ls.Written = 0
+	if len(data) == 0 || data[0].isNull == 1 {
+		return nil
+	}
+	if len(data) > 1 {
+		return fmt.Errorf("LobStream: needs exactly one returned row, got %d", len(data))
+	}
+	if ls.Reader == nil {
+		return errors.New("LobStream: nil Reader")
+	}
+	lob := C.dpiData_getLOB(&data[0])
+	if lob == nil {
+		return nil
+	}
+	var typ C.dpiOracleTypeNum
+	if err := c.checkExec(func() C.int { return C.dpiLob_getType(lob, &typ) }); err != nil {
+		return fmt.Errorf("LobStream: getType: %w", err)
+	}
+	isClob := typ == C.DPI_ORACLE_TYPE_CLOB || typ == C.DPI_ORACLE_TYPE_NCLOB
+	chunkSize := ls.ChunkSize
+	if chunkSize <= 0 {
+		var cs C.uint32_t
+		if err := c.checkExec(func() C.int { return C.dpiLob_getChunkSize(lob, &cs) }); err != nil {
+			chunkSize = 8192
+			if logger := getLogger(ctx); logger != nil {
+				logger.Warn("LobStream: getChunkSize", "error", err, "chunkSize", chunkSize)
+			}
+		} else if chunkSize = int(cs); chunkSize == 0 {
+			chunkSize = minChunkSize
+		}
+		for chunkSize < minChunkSize {
+			chunkSize <<= 1
+		}
+	}
+	// The locator belongs to the variable, so hold a reference to it for the writer's Close.
+	if err := c.checkExec(func() C.int { return C.dpiLob_addRef(lob) }); err != nil {
+		return fmt.Errorf("addRef: %w", err)
+	}
+	lw := &dpiLobWriter{drv: c.drv, dpiLob: lob, isClob: isClob}
+	buf := make([]byte, chunkSize)
+	logger := getLogger(ctx)
+	for {
+		if err := ctx.Err(); err != nil {
+			_ = lw.Close()
+			return err
+		}
+		n, err := io.ReadFull(ls.Reader, buf)
+		if n != 0 {
+			if _, wErr := lw.Write(buf[:n]); wErr != nil {
+				_ = lw.Close()
+				return fmt.Errorf("LobStream: written %d: %w", ls.Written, wErr)
+			}
+			ls.Written += int64(n)
+			if ls.Progress != nil {
+				ls.Progress(ls.Written)
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			_ = lw.Close()
+			return fmt.Errorf("LobStream: read after %d: %w", ls.Written, err)
+		}
+	}
+	if logger != nil && logger.Enabled(ctx, slog.LevelDebug) {
+		logger.Debug("LobStream", "written", ls.Written, "chunkSize", chunkSize, "isClob", isClob)
+	}
+	return lw.Close()
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import "testing"
+
+func TestUTF16Len(t *testing.T) {
+	t.Parallel()
+	for _, tC := range []struct {
+		s    string
+		want int64
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"árvíztűrő", 9},
+		{"a😀b", 4}, // surrogate pair
+		{"\xff", 1},
+	} {
+		if got := utf16Len([]byte(tC.s)); got != tC.want {
+			t.Errorf("%q: got %d, wanted %d", tC.s, got, tC.want)
+		}
+	}
+}
+
+func TestTrimIncompleteUTF8(t *testing.T) {
+	t.Parallel()
+	full := []byte("a😀")
+	for i := 0; i <= len(full); i++ {
+		want := i
+		if i > 1 && i < len(full) {
+			want = 1
+		}
+		if got := trimIncompleteUTF8(full[:i]); got != want {
+			t.Errorf("%q: got %d, wanted %d", full[:i], got, want)
+		}
+	}
+	if got := trimIncompleteUTF8([]byte("�")); got != 3 {
+		t.Errorf("replacement char trimmed: %d", got)
+	}
+}
+
+func TestLobWriterSplitRunes(t *testing.T) {
+	t.Parallel()
+	const text = "é€a😀"
+	b := []byte(text)
+	for _, cuts := range [][]int{
+		{1, 4},
+		{1, 2, 3, 4, 5, 6, 7},
+		{3, 5},
+		{6},
+	} {
+		var got []byte
+		write := func(p []byte) (int, error) {
+			got = append(got, p...)
+			return len(p), nil
+		}
+		var dlw dpiLobWriter
+		var last int
+		for _, cut := range append(cuts, len(b)) {
+			n, err := dlw.writeRunes(b[last:cut], write)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != cut-last {
+				t.Errorf("%v: wrote %d, wanted %d", cuts, n, cut-last)
+			}
+			last = cut
+		}
+		if len(dlw.partial) != 0 {
+			t.Errorf("%v: partial %q remained", cuts, dlw.partial)
+		}
+		if string(got) != text {
+			t.Errorf("%v: got %q, wanted %q", cuts, got, text)
+		}
+	}
+}
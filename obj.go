@@ -678,6 +678,52 @@ func (O ObjectCollection) AsSlice(dest interface{}) (interface{}, error) {
 	return dr.Interface(), nil
 }
 
+// AsIndexMap retrieves the collection into a map keyed by the element indices,
+// preserving the gaps of sparse collections (associative arrays, nested tables with deleted elements).
+func (O ObjectCollection) AsIndexMap() (map[int32]interface{}, error) {
+	if O.Object == nil || O.dpiObject == nil {
+		return nil, nil
+	}
+	length, err := O.Len()
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[int32]interface{}, length)
+	d := scratch.Get()
+	defer scratch.Put(d)
+	for i, err := O.First(); err == nil; i, err = O.Next(i) {
+		if O.CollectionOf.IsObject() {
+			d.ObjectType = O.CollectionOf
+		}
+		if err = O.GetItem(d, i); err != nil {
+			return m, fmt.Errorf("get(%d): %w", i, err)
+		}
+		v := d.Get()
+		if !d.IsObject() {
+			v = maybeString(v, O.CollectionOf)
+			if b, ok := v.([]byte); ok {
+				v = bytes.Clone(b)
+			}
+		}
+		m[int32(i)] = v
+	}
+	return m, nil
+}
+
+// FromIndexMap sets the elements of the collection at the indices of the map,
+// so sparse associative arrays can be populated.
+func (O ObjectCollection) FromIndexMap(m map[int32]interface{}) error {
+	if O.Object == nil || O.dpiObject == nil {
+		return nil
+	}
+	for i, v := range m {
+		if err := O.Set(int(i), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ToJSON writes the ObjectCollection as JSON to the io.Writer.
 func (O ObjectCollection) ToJSON(w io.Writer) error {
 	var notFirst bool
@@ -868,6 +914,21 @@ func (O ObjectCollection) Next(i int) (int, error) {
 	return 0, ErrNotExist
 }
 
+// Prev returns the preceding index of i, to iterate backwards from Last.
+func (O ObjectCollection) Prev(i int) (int, error) {
+	var exists C.int
+	var idx C.int32_t
+	if err := O.drv.checkExec(func() C.int {
+		return C.dpiObject_getPrevIndex(O.dpiObject, C.int32_t(i), &idx, &exists)
+	}); err != nil {
+		return 0, fmt.Errorf("prev(%d): %w", i, err)
+	}
+	if exists == 1 {
+		return int(idx), nil
+	}
+	return 0, ErrNotExist
+}
+
 // Len returns the length of the collection.
 func (O ObjectCollection) Len() (int, error) {
 	var size C.int32_t
@@ -916,20 +977,111 @@ func (t *ObjectType) String() string {
 	if t == nil {
 		return ""
 	}
-	if t.Schema == "" {
-		if t.PackageName == "" {
-			return t.Name
+	return fullTypeName(t.Schema, t.PackageName, t.Name)
+}
+
+func fullTypeName(schema, pkg, name string) string {
+	if schema == "" {
+		if pkg == "" {
+			return name
 		}
-		return t.PackageName + "." + t.Name
+		return pkg + "." + name
 	}
-	if t.PackageName == "" {
-		return t.Schema + "." + t.Name
+	if pkg == "" {
+		return schema + "." + name
+	}
+	return schema + "." + pkg + "." + name
+}
+
+// DatabaseTypeName returns the database type name (without the length) of the type,
+// such as "NUMBER" or "VARCHAR2" for scalar attributes and collection elements, "OBJECT" for objects and collections.
+func (t *ObjectType) DatabaseTypeName() string {
+	if t == nil {
+		return ""
 	}
-	return t.Schema + "." + t.PackageName + "." + t.Name
+	return oracleTypeName(t.OracleTypeNum)
 }
 
 func (t *ObjectType) IsObject() bool { return t != nil && t.NativeTypeNum == C.DPI_NATIVE_TYPE_OBJECT }
 
+// ObjectTypeDescription is a plain, serializable description of an ObjectType,
+// without any connection-bound handle, as returned by ObjectType.Describe.
+type ObjectTypeDescription struct {
+	// CollectionOf is the description of the element type of a collection.
+	CollectionOf *ObjectTypeDescription `json:",omitempty"`
+	Schema       string                 `json:",omitempty"`
+	Package      string                 `json:",omitempty"`
+	Name         string                 `json:",omitempty"`
+	// DatabaseType is the database type name, as returned by ObjectType.DatabaseTypeName.
+	DatabaseType string
+	// Attributes of an object type, ordered by Sequence.
+	Attributes  []ObjectAttributeDescription `json:",omitempty"`
+	DBSize      int                          `json:",omitempty"`
+	CharSize    int                          `json:",omitempty"`
+	Precision   int16                        `json:",omitempty"`
+	Scale       int8                         `json:",omitempty"`
+	FsPrecision uint8                        `json:",omitempty"`
+}
+
+// ObjectAttributeDescription is the description of an attribute of an object type.
+type ObjectAttributeDescription struct {
+	Name     string
+	Type     ObjectTypeDescription
+	Sequence uint32
+}
+
+// IsObject reports whether the description is of an object or collection type.
+func (d ObjectTypeDescription) IsObject() bool { return d.DatabaseType == "OBJECT" }
+
+// FullName returns the name of the described type with the schema and package prepended.
+func (d ObjectTypeDescription) FullName() string {
+	return fullTypeName(d.Schema, d.Package, d.Name)
+}
+
+// Describe returns the plain description of the type,
+// walking its attributes and collection elements recursively.
+func (t *ObjectType) Describe() ObjectTypeDescription {
+	return t.describe(nil)
+}
+
+func (t *ObjectType) describe(seen map[*ObjectType]struct{}) ObjectTypeDescription {
+	if t == nil {
+		return ObjectTypeDescription{}
+	}
+	d := ObjectTypeDescription{
+		Schema: t.Schema, Package: t.PackageName, Name: t.Name,
+		DatabaseType: t.DatabaseTypeName(),
+		DBSize:       t.DBSize, CharSize: t.CharSize,
+		Precision: t.Precision, Scale: t.Scale, FsPrecision: t.FsPrecision,
+	}
+	if !t.IsObject() {
+		return d
+	}
+	// Do not loop on (REF-)recursive types.
+	if _, ok := seen[t]; ok {
+		return d
+	}
+	if seen == nil {
+		seen = make(map[*ObjectType]struct{})
+	}
+	seen[t] = struct{}{}
+	defer delete(seen, t)
+	if t.CollectionOf != nil {
+		elt := t.CollectionOf.describe(seen)
+		d.CollectionOf = &elt
+	}
+	if len(t.Attributes) != 0 {
+		d.Attributes = make([]ObjectAttributeDescription, 0, len(t.Attributes))
+		for _, nm := range t.AttributeNames() {
+			a := t.Attributes[nm]
+			d.Attributes = append(d.Attributes, ObjectAttributeDescription{
+				Name: nm, Sequence: a.Sequence, Type: a.ObjectType.describe(seen),
+			})
+		}
+	}
+	return d
+}
+
 // FullName returns the object's name with the schame prepended.
 func (t *ObjectType) FullName() string { return t.String() }
 
@@ -961,6 +1113,21 @@ func (c *conn) GetObjectType(name string) (*ObjectType, error) {
 		delete(c.objTypes, t.FullName())
 	}
 
+	t, err := c.getObjectType(name, c.objTypes)
+	if err != nil {
+		return t, err
+	}
+	if name != t.FullName() {
+		c.objTypes[name] = t
+	}
+	//fmt.Printf("GetObjectType(%q/%q) NEW: %p\n", name, t.FullName(), t)
+	return t, nil
+}
+
+// getObjectType gets the named ObjectType from the database, putting it and its attributes' types into cache.
+//
+// Must be called with c.mu (read) locked.
+func (c *conn) getObjectType(name string, cache map[string]*ObjectType) (*ObjectType, error) {
 	objType := (*C.dpiObjectType)(C.malloc(C.sizeof_void))
 	cName := C.CString(name)
 	err := c.checkExec(func() C.int {
@@ -977,14 +1144,7 @@ func (c *conn) GetObjectType(name string) (*ObjectType, error) {
 		return nil, fmt.Errorf("getObjectType(%q) conn=%p: %w", name, c.dpiConn, err)
 	}
 	t := &ObjectType{drv: c.drv, dpiObjectType: objType}
-	if err = t.init(c.objTypes); err != nil {
-		return t, err
-	}
-	if name != t.FullName() {
-		c.objTypes[name] = t
-	}
-	//fmt.Printf("GetObjectType(%q/%q) NEW: %p\n", name, t.FullName(), t)
-	return t, nil
+	return t, t.init(cache)
 }
 
 var errNilObjectType = errors.New("ObjectType is nil")
@@ -0,0 +1,282 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultObjectTypeCacheTTL is the default time-to-live of the cached object type descriptions.
+const DefaultObjectTypeCacheTTL = 10 * time.Minute
+
+var objectTypeCacheTTL atomic.Int64
+
+func init() { objectTypeCacheTTL.Store(int64(DefaultObjectTypeCacheTTL)) }
+
+// SetObjectTypeCacheTTL sets the time-to-live of the driver-level cache of object type descriptions
+// used by DescribeObjectType. A non-positive ttl disables the cache.
+func SetObjectTypeCacheTTL(ttl time.Duration) { objectTypeCacheTTL.Store(int64(ttl)) }
+
+type objTypeDescEntry struct {
+	at   time.Time
+	desc ObjectTypeDescription
+}
+
+// objTypeDescCache caches the ObjectTypeDescriptions by pool (or connection parameters)
+// and type name, to be shared between the connections.
+type objTypeDescCache map[string]objTypeDescEntry
+
+func (m objTypeDescCache) get(key string, now time.Time, ttl time.Duration) (ObjectTypeDescription, bool) {
+	e, ok := m[key]
+	if !ok {
+		return ObjectTypeDescription{}, false
+	}
+	if ttl <= 0 || now.Sub(e.at) >= ttl {
+		delete(m, key)
+		return ObjectTypeDescription{}, false
+	}
+	return e.desc, true
+}
+
+// invalidate the entries with the prefix, and one of the names (all if no names are given).
+func (m objTypeDescCache) invalidate(prefix string, names ...string) {
+	for k, e := range m {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		if len(names) == 0 {
+			delete(m, k)
+			continue
+		}
+		nm, full := k[len(prefix):], e.desc.FullName()
+		for _, n := range names {
+			if n == nm || n == full || n == e.desc.Name {
+				delete(m, k)
+				break
+			}
+		}
+	}
+}
+
+// objTypeCacheKey returns the prefix of the object type description cache keys of the connection:
+// the pool key for pooled connections, the user and connect string for standalone ones.
+func (c *conn) objTypeCacheKey() string {
+	if c.poolKey != "" {
+		return c.poolKey + "\t" + c.params.Username + "\t"
+	}
+	return "\t" + c.params.Username + "\t" + c.params.ConnectString + "\t"
+}
+
+// DescribeObjectType returns the description of the named object type.
+//
+// The descriptions are cached in the driver, shared by the connections of the same pool
+// (or standalone connections with the same user and connect string),
+// for the time set by SetObjectTypeCacheTTL (DefaultObjectTypeCacheTTL by default),
+// so only the first call does the round trips of GetObjectType.
+//
+// When the cached description is expired (or invalidated), the current one is got from the database,
+// and the connection's cached ObjectType is dropped only if it differs from that.
+//
+// To drop the cached descriptions on the changes of the types, see SubscribeObjectTypeChanges
+// and InvalidateObjectTypeCache.
+func DescribeObjectType(ctx context.Context, ex Execer, typeName string) (ObjectTypeDescription, error) {
+	c, err := getConn(ctx, ex)
+	if err != nil {
+		return ObjectTypeDescription{}, fmt.Errorf("getConn for %s: %w", typeName, err)
+	}
+	return c.describeObjectType(typeName)
+}
+
+func (c *conn) describeObjectType(name string) (ObjectTypeDescription, error) {
+	if !strings.Contains(name, "\"") {
+		name = strings.ToUpper(name)
+	}
+	ttl := time.Duration(objectTypeCacheTTL.Load())
+	key := c.objTypeCacheKey() + name
+	d := c.drv
+	if ttl > 0 {
+		d.mu.Lock()
+		desc, ok := d.objTypeDescs.get(key, time.Now(), ttl)
+		d.mu.Unlock()
+		if ok {
+			return desc, nil
+		}
+	}
+	desc, err := c.refreshObjectType(name)
+	if err != nil {
+		return ObjectTypeDescription{}, err
+	}
+	if ttl > 0 {
+		d.mu.Lock()
+		if d.objTypeDescs == nil {
+			d.objTypeDescs = make(objTypeDescCache)
+		}
+		d.objTypeDescs[key] = objTypeDescEntry{desc: desc, at: time.Now()}
+		d.mu.Unlock()
+	}
+	return desc, nil
+}
+
+// refreshObjectType returns the current description of the named object type.
+//
+// The connection's cached ObjectType is kept if it matches the current description,
+// else it is dropped from the cache, for GetObjectType to get the current one.
+func (c *conn) refreshObjectType(name string) (ObjectTypeDescription, error) {
+	c.mu.RLock()
+	cached := c.objTypes[name]
+	c.mu.RUnlock()
+	if cached == nil || cached.drv == nil {
+		t, err := c.GetObjectType(name)
+		if err != nil {
+			return ObjectTypeDescription{}, err
+		}
+		return t.Describe(), nil
+	}
+
+	c.mu.RLock()
+	if c.dpiConn == nil {
+		c.mu.RUnlock()
+		return ObjectTypeDescription{}, driver.ErrBadConn
+	}
+	// not cached, as objects may reference the cached one
+	t, err := c.getObjectType(name, nil)
+	c.mu.RUnlock()
+	if err != nil {
+		_ = t.Close()
+		return ObjectTypeDescription{}, err
+	}
+	desc := t.Describe()
+	if err = t.Close(); err != nil {
+		return ObjectTypeDescription{}, err
+	}
+	if !reflect.DeepEqual(desc, cached.Describe()) {
+		c.mu.Lock()
+		if c.objTypes[name] == cached {
+			c.retireObjectType(cached)
+		}
+		c.mu.Unlock()
+	}
+	return desc, nil
+}
+
+// InvalidateObjectTypeCache drops the named (all, if no names are given) object types'
+// cached descriptions of the connection's pool (or user and connect string),
+// and the connection's own cached ObjectTypes that differ from their current description.
+//
+// Call it after changing the types (CREATE OR REPLACE TYPE, ALTER TYPE),
+// if the changes are not watched by SubscribeObjectTypeChanges.
+func InvalidateObjectTypeCache(ctx context.Context, ex Execer, names ...string) error {
+	c, err := getConn(ctx, ex)
+	if err != nil {
+		return fmt.Errorf("getConn: %w", err)
+	}
+	return c.invalidateObjectTypeCache(names...)
+}
+
+func (c *conn) invalidateObjectTypeCache(names ...string) error {
+	names = append([]string(nil), names...)
+	for i, nm := range names {
+		if !strings.Contains(nm, "\"") {
+			names[i] = strings.ToUpper(nm)
+		}
+	}
+	d := c.drv
+	d.mu.Lock()
+	d.objTypeDescs.invalidate(c.objTypeCacheKey(), names...)
+	d.mu.Unlock()
+
+	// the name each cached ObjectType was got with
+	cached := make(map[*ObjectType]string)
+	c.mu.RLock()
+	for k, t := range c.objTypes {
+		if t.drv == nil {
+			continue
+		}
+		if len(names) != 0 {
+			var found bool
+			for _, nm := range names {
+				if found = nm == k || nm == t.FullName() || nm == t.Name; found {
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		cached[t] = k
+	}
+	c.mu.RUnlock()
+
+	var errs []error
+	for _, k := range cached {
+		if _, err := c.refreshObjectType(k); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", k, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// retireObjectType drops t from the connection's cached ObjectTypes.
+// As objects may still reference it, it is closed only with the connection.
+//
+// Must be called with c.mu locked.
+func (c *conn) retireObjectType(t *ObjectType) {
+	for k, v := range c.objTypes {
+		if v == t {
+			delete(c.objTypes, k)
+		}
+	}
+	c.retiredObjTypes = append(c.retiredObjTypes, t)
+}
+
+// objTypeChangeQueries are registered by SubscribeObjectTypeChanges.
+var objTypeChangeQueries = []string{
+	"SELECT owner, type_name, attributes, methods FROM all_types",
+	"SELECT owner, type_name, attr_name, attr_type_owner, attr_type_name, length, precision, scale FROM all_type_attrs",
+}
+
+// SubscribeObjectTypeChanges watches the changes of the object types (ALL_TYPES and ALL_TYPE_ATTRS)
+// with a change notification Subscription, and drops all the cached object type descriptions
+// of the connection's pool (or user and connect string) on each notification,
+// just as InvalidateObjectTypeCache without names, but without refreshing the connections' cached ObjectTypes:
+// they are refreshed by their next DescribeObjectType.
+//
+// The Subscription is on the connection of ex, so that should be a *sql.Conn kept open while watching
+// (or use SubscrAutoResubscribe). The connection must be opened with "enableEvents=1",
+// and the user needs the CHANGE NOTIFICATION privilege.
+// The options are applied after SubscrQueryLevel(true).
+//
+// Close the returned Subscription to stop watching.
+func SubscribeObjectTypeChanges(ctx context.Context, ex Execer, options ...SubscriptionOption) (*Subscription, error) {
+	c, err := getConn(ctx, ex)
+	if err != nil {
+		return nil, fmt.Errorf("getConn: %w", err)
+	}
+	d, prefix := c.drv, c.objTypeCacheKey()
+	// Any event may mean missed changes (deregistration, shutdown, resubscription), so invalidate on all.
+	s, err := c.NewSubscription("", func(Event) {
+		d.mu.Lock()
+		d.objTypeDescs.invalidate(prefix)
+		d.mu.Unlock()
+	}, append([]SubscriptionOption{SubscrQueryLevel(true)}, options...)...)
+	if err != nil {
+		return nil, err
+	}
+	for _, qry := range objTypeChangeQueries {
+		if err = s.Register(qry); err != nil {
+			_ = s.Close()
+			return nil, fmt.Errorf("register %q: %w", qry, err)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestObjTypeDescCache(t *testing.T) {
+	const prefix, other = "pool\tscott\t", "\tscott\tdb\t"
+	now := time.Now()
+	m := make(objTypeDescCache)
+	for _, k := range []string{prefix, other} {
+		m[k+"EMP_OT"] = objTypeDescEntry{at: now, desc: ObjectTypeDescription{Schema: "SCOTT", Name: "EMP_OT", DatabaseType: "OBJECT"}}
+		m[k+"SCOTT.EMP_TAB"] = objTypeDescEntry{at: now, desc: ObjectTypeDescription{Schema: "SCOTT", Name: "EMP_TAB", DatabaseType: "OBJECT"}}
+	}
+
+	if _, ok := m.get(prefix+"EMP_OT", now.Add(time.Minute), time.Hour); !ok {
+		t.Error("EMP_OT not found")
+	}
+	if _, ok := m.get(prefix+"EMP_OT", now.Add(time.Hour), time.Hour); ok {
+		t.Error("EMP_OT found after TTL")
+	}
+	if _, ok := m[prefix+"EMP_OT"]; ok {
+		t.Error("expired EMP_OT is still cached")
+	}
+
+	m.invalidate(prefix, "SCOTT.EMP_TAB")
+	if _, ok := m[prefix+"SCOTT.EMP_TAB"]; ok {
+		t.Error("SCOTT.EMP_TAB is not invalidated")
+	}
+	if len(m) != 2 {
+		t.Errorf("other pool's entries are invalidated: %v", m)
+	}
+	m.invalidate(other)
+	if len(m) != 0 {
+		t.Errorf("not all entries are invalidated: %v", m)
+	}
+}
+
+func TestInvalidateObjectTypeCache(t *testing.T) {
+	const prefix = "\tSCOTT\tdb\t"
+	emp, dept := &ObjectType{Schema: "SCOTT", Name: "EMP_OT"}, &ObjectType{Schema: "SCOTT", Name: "DEPT_OT"}
+	c := &conn{
+		drv: &drv{objTypeDescs: objTypeDescCache{prefix + "EMP_OT": {}, prefix + "DEPT_OT": {}}},
+		objTypes: map[string]*ObjectType{
+			"EMP_OT": emp, "SCOTT.EMP_OT": emp, "SCOTT.DEPT_OT": dept,
+		},
+	}
+	c.params.Username, c.params.ConnectString = "SCOTT", "db"
+	// The (closed) cached ObjectTypes are not refreshed, only the descriptions are dropped.
+	if err := c.invalidateObjectTypeCache("emp_ot"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.drv.objTypeDescs[prefix+"DEPT_OT"]; !ok || len(c.drv.objTypeDescs) != 1 {
+		t.Errorf("descriptions: got %v", c.drv.objTypeDescs)
+	}
+	if len(c.objTypes) != 3 || len(c.retiredObjTypes) != 0 {
+		t.Errorf("objTypes: got %v, retired %v", c.objTypes, c.retiredObjTypes)
+	}
+
+	c.retireObjectType(emp)
+	if len(c.objTypes) != 1 || c.objTypes["SCOTT.DEPT_OT"] != dept {
+		t.Errorf("objTypes: got %v", c.objTypes)
+	}
+	// not closed, as objects may still reference it, but closed with the connection.
+	if len(c.retiredObjTypes) != 1 || c.retiredObjTypes[0] != emp {
+		t.Errorf("retired: got %v", c.retiredObjTypes)
+	}
+}
+
+func TestObjectTypeDescriptionJSON(t *testing.T) {
+	want := ObjectTypeDescription{
+		Schema: "SCOTT", Name: "EMP_OT", DatabaseType: "OBJECT",
+		Attributes: []ObjectAttributeDescription{
+			{Name: "ID", Type: ObjectTypeDescription{DatabaseType: "NUMBER", Precision: 10, Scale: 2}},
+			{Name: "PHONES", Sequence: 1, Type: ObjectTypeDescription{
+				Schema: "SCOTT", Package: "EMP_PKG", Name: "PHONE_TAB", DatabaseType: "OBJECT",
+				CollectionOf: &ObjectTypeDescription{DatabaseType: "VARCHAR2", CharSize: 20, DBSize: 20},
+			}},
+		},
+	}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%s", b)
+	var got ObjectTypeDescription
+	if err = json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Error(d)
+	}
+	if got, want := want.Attributes[1].Type.FullName(), "SCOTT.EMP_PKG.PHONE_TAB"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
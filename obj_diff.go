@@ -0,0 +1,238 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+/*
+#include "dpiImpl.h"
+*/
+import "C"
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// AttrChange is a changed attribute (or collection element) of an Object, as returned by Object.Diff.
+type AttrChange struct {
+	// Old and New are the values, nil for NULL or missing collection elements.
+	// Nested objects and collections are compared recursively, only their changed
+	// attributes and elements are reported - except when one side is NULL (or of different type),
+	// then the other is reported as Object.AsMap(true) or ObjectCollection.AsMapSlice(true) / AsSlice.
+	Old, New interface{}
+	// Path of the attribute, such as "ADDRESS.CITY" or "PHONES[2].NUM".
+	// It is empty when the compared objects themselves differ (one is NULL or they are of different type).
+	Path string
+}
+
+func (c AttrChange) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Old, c.New)
+}
+
+// Copy returns a deep copy of the object.
+//
+// As with all Objects, you MUST call Close on it when not needed anymore!
+func (O *Object) Copy() (*Object, error) {
+	if O == nil || O.dpiObject == nil {
+		return nil, nil
+	}
+	var obj *C.dpiObject
+	if err := O.drv.checkExec(func() C.int { return C.dpiObject_copy(O.dpiObject, &obj) }); err != nil {
+		return nil, fmt.Errorf("copy %s: %w", O.ObjectType, err)
+	}
+	return &Object{dpiObject: obj, ObjectType: O.ObjectType}, nil
+}
+
+// Equal reports whether the object and other are of the same type
+// and have equal attributes, recursively.
+//
+// It returns false if the comparison fails - call Diff to see the error.
+func (O *Object) Equal(other *Object) bool {
+	changes, err := O.Diff(other)
+	return err == nil && len(changes) == 0
+}
+
+// Diff returns the changes from the object to other, walking the nested objects and collections.
+// Collection elements are matched by index, so a deleted element shows as a change to nil.
+//
+// This can be used to see which attributes of an IN OUT object parameter has been changed by a call:
+//
+//	before, _ := obj.Copy()
+//	defer before.Close()
+//	db.ExecContext(ctx, "BEGIN my_pkg.proc(:1); END;", sql.Out{Dest: obj, In: true})
+//	changes, err := before.Diff(obj)
+func (O *Object) Diff(other *Object) ([]AttrChange, error) {
+	var changes []AttrChange
+	err := diffObjects(&changes, "", O, other)
+	return changes, err
+}
+
+func isNullObject(O *Object) bool { return O == nil || O.dpiObject == nil }
+
+func diffObjects(changes *[]AttrChange, path string, a, b *Object) error {
+	if isNullObject(a) && isNullObject(b) {
+		return nil
+	}
+	if isNullObject(a) || isNullObject(b) || a.ObjectType.FullName() != b.ObjectType.FullName() {
+		return appendObjectChange(changes, path, a, b)
+	}
+	if a.ObjectType.CollectionOf != nil {
+		return diffCollections(changes, path, a.Collection(), b.Collection())
+	}
+	for _, name := range a.AttributeNames() {
+		va, err := a.Get(name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", joinAttrPath(path, name), err)
+		}
+		vb, err := b.Get(name)
+		if err != nil {
+			releaseDiffValue(va)
+			return fmt.Errorf("%s: %w", joinAttrPath(path, name), err)
+		}
+		err = diffValues(changes, joinAttrPath(path, name), va, vb)
+		releaseDiffValue(va)
+		releaseDiffValue(vb)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func diffCollections(changes *[]AttrChange, path string, a, b ObjectCollection) error {
+	ma, err := a.AsIndexMap()
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	defer releaseDiffValues(ma)
+	mb, err := b.AsIndexMap()
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	defer releaseDiffValues(mb)
+	indices := make([]int32, 0, len(ma)+len(mb))
+	for i := range ma {
+		indices = append(indices, i)
+	}
+	for i := range mb {
+		if _, ok := ma[i]; !ok {
+			indices = append(indices, i)
+		}
+	}
+	slices.Sort(indices)
+	for _, i := range indices {
+		if err := diffValues(changes, path+"["+strconv.Itoa(int(i))+"]", ma[i], mb[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func diffValues(changes *[]AttrChange, path string, a, b interface{}) error {
+	oa, aIsObj := asDiffObject(a)
+	ob, bIsObj := asDiffObject(b)
+	if aIsObj || bIsObj {
+		return diffObjects(changes, path, oa, ob)
+	}
+	if !attrValueEqual(a, b) {
+		*changes = append(*changes, AttrChange{Path: path, Old: cloneDiffValue(a), New: cloneDiffValue(b)})
+	}
+	return nil
+}
+
+// attrValueEqual reports whether the scalar attribute values are equal.
+func attrValueEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	switch a := a.(type) {
+	case []byte:
+		b, ok := b.([]byte)
+		return ok && bytes.Equal(a, b)
+	case time.Time:
+		b, ok := b.(time.Time)
+		return ok && a.Equal(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func joinAttrPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func asDiffObject(v interface{}) (*Object, bool) {
+	switch x := v.(type) {
+	case *Object:
+		return x, true
+	case *ObjectCollection:
+		if x == nil {
+			return nil, true
+		}
+		return x.Object, true
+	case ObjectCollection:
+		return x.Object, true
+	}
+	return nil, false
+}
+
+func appendObjectChange(changes *[]AttrChange, path string, a, b *Object) error {
+	va, err := objectSnapshot(a)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	vb, err := objectSnapshot(b)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	*changes = append(*changes, AttrChange{Path: path, Old: va, New: vb})
+	return nil
+}
+
+// objectSnapshot returns the contents of the object as plain Go values,
+// as the sub-objects are released after the comparison.
+func objectSnapshot(O *Object) (interface{}, error) {
+	if isNullObject(O) {
+		return nil, nil
+	}
+	if O.ObjectType.CollectionOf == nil {
+		return O.AsMap(true)
+	}
+	if O.ObjectType.CollectionOf.IsObject() {
+		return O.Collection().AsMapSlice(true)
+	}
+	return O.Collection().AsSlice(nil)
+}
+
+// cloneDiffValue copies the []byte values, as those point into the object's memory.
+func cloneDiffValue(v interface{}) interface{} {
+	if b, ok := v.([]byte); ok {
+		return bytes.Clone(b)
+	}
+	return v
+}
+
+// releaseDiffValue releases the reference to the sub-object got by Object.Get or ObjectCollection.AsIndexMap.
+//
+// It does not call Close, as that would reset the attributes of the shared instance.
+func releaseDiffValue(v interface{}) {
+	O, ok := asDiffObject(v)
+	if !ok || isNullObject(O) {
+		return
+	}
+	_ = O.drv.checkExec(func() C.int { return C.dpiObject_release(O.dpiObject) })
+	O.dpiObject = nil
+}
+
+func releaseDiffValues(m map[int32]interface{}) {
+	for _, v := range m {
+		releaseDiffValue(v)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffValues(t *testing.T) {
+	now := time.Now()
+	var changes []AttrChange
+	for _, tc := range []struct {
+		a, b interface{}
+		path string
+	}{
+		{path: "NIL"},
+		{path: "STR", a: "a", b: "a"},
+		{path: "STR_CHANGED", a: "a", b: "b"},
+		{path: "STR_NULLED", a: "a"},
+		{path: "NUM", a: int64(1), b: int64(1)},
+		{path: "NUM_CHANGED", a: int64(1), b: int64(2)},
+		{path: "RAW", a: []byte("ab"), b: []byte("ab")},
+		{path: "RAW_CHANGED", a: []byte("ab"), b: []byte("ac")},
+		{path: "DATE", a: now, b: now.In(time.UTC)},
+		{path: "DATE_CHANGED", a: now, b: now.Add(time.Second)},
+	} {
+		if err := diffValues(&changes, tc.path, tc.a, tc.b); err != nil {
+			t.Fatalf("%s: %+v", tc.path, err)
+		}
+	}
+	want := []AttrChange{
+		{Path: "STR_CHANGED", Old: "a", New: "b"},
+		{Path: "STR_NULLED", Old: "a"},
+		{Path: "NUM_CHANGED", Old: int64(1), New: int64(2)},
+		{Path: "RAW_CHANGED", Old: []byte("ab"), New: []byte("ac")},
+		{Path: "DATE_CHANGED", Old: now, New: now.Add(time.Second)},
+	}
+	if d := cmp.Diff(want, changes); d != "" {
+		t.Error(d)
+	}
+}
+
+func TestJoinAttrPath(t *testing.T) {
+	if got := joinAttrPath(joinAttrPath("", "ADDR")+"[2]", "CITY"); got != "ADDR[2].CITY" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// OracleTypeNamer is implemented by the types that know the name of their Oracle object type,
+// as an alternative of the ObjectTypeName field, for ObjectOf.
+type OracleTypeNamer interface {
+	OracleTypeName() string
+}
+
+// genericObject is implemented by Collection and ObjectOf,
+// to be bound with the struct - object mapping (dataSetObjectStruct, dataGetObjectStruct).
+type genericObject interface {
+	objectType(context.Context, *conn) (*ObjectType, error)
+	// objectValue returns the value to be converted to the object.
+	objectValue() interface{}
+}
+
+// genericObjectDest is implemented by *Collection and *ObjectOf.
+type genericObjectDest interface {
+	genericObject
+	// objectDest returns the pointer of the value to be set from the object.
+	objectDest() interface{}
+}
+
+var (
+	_ genericObjectDest = (*Collection[string])(nil)
+	_ genericObjectDest = (*ObjectOf[struct{}])(nil)
+	_ sql.Scanner       = (*Collection[string])(nil)
+	_ sql.Scanner       = (*ObjectOf[struct{}])(nil)
+)
+
+// Collection is a collection of Items, bound as the TypeName collection type.
+//
+// The elements are scalars (for collections of VARCHAR2, NUMBER ...), or structs mapped
+// to the element object type the same way as with ObjectTypeName (by the godror struct tags).
+//
+// The native object is created at bind, and released after the call,
+// so there is nothing to Close. nil Items are bound as NULL, use an empty slice for an empty collection.
+// Use a *Collection for OUT and IN OUT parameters:
+//
+//	phones := godror.Collection[string]{TypeName: "HR.PHONE_TAB", Items: []string{"+36 1 234 5678"}}
+//	_, err := db.ExecContext(ctx, "BEGIN hr.emp_pkg.add_phone(:1); END;", sql.Out{Dest: &phones, In: true})
+//
+// It can also be Scanned from a collection typed column.
+type Collection[T any] struct {
+	// TypeName of the collection type, such as "HR.PHONE_TAB".
+	TypeName string
+	Items    []T
+}
+
+func (coll Collection[T]) objectType(ctx context.Context, c *conn) (*ObjectType, error) {
+	if coll.TypeName == "" {
+		return nil, errors.New("Collection has no TypeName")
+	}
+	return c.GetObjectType(coll.TypeName)
+}
+func (coll Collection[T]) objectValue() interface{} { return coll.Items }
+func (coll *Collection[T]) objectDest() interface{} { return &coll.Items }
+
+// Scan the collection Object (as returned for collection typed columns) into Items, and Close it.
+func (coll *Collection[T]) Scan(src interface{}) error { return scanGenericObject(coll, src) }
+
+// ObjectOf is a Value bound as an object of type TypeName.
+//
+// T is a struct mapped to the object type the same way as with ObjectTypeName (by the godror struct tags),
+// but it does not need an ObjectTypeName field if TypeName is given.
+// If TypeName is empty, then T's OracleTypeName method or its ObjectTypeName field's tag names the type.
+//
+// The native object is created at bind, and released after the call,
+// so there is nothing to Close. The zero Value is bound as NULL.
+// Use an *ObjectOf for OUT and IN OUT parameters.
+type ObjectOf[T any] struct {
+	// TypeName of the object type, such as "HR.EMP_OT".
+	TypeName string
+	Value    T
+}
+
+func (O ObjectOf[T]) objectType(ctx context.Context, c *conn) (*ObjectType, error) {
+	if O.TypeName != "" {
+		return c.GetObjectType(O.TypeName)
+	}
+	if n, ok := interface{}(O.Value).(OracleTypeNamer); ok {
+		return c.GetObjectType(n.OracleTypeName())
+	}
+	if n, ok := interface{}(&O.Value).(OracleTypeNamer); ok {
+		return c.GetObjectType(n.OracleTypeName())
+	}
+	return c.getStructObjectType(ctx, &O.Value, "")
+}
+func (O ObjectOf[T]) objectValue() interface{} { return &O.Value }
+func (O *ObjectOf[T]) objectDest() interface{} { return &O.Value }
+
+// Scan the Object (as returned for object typed columns) into Value, and Close it.
+func (O *ObjectOf[T]) Scan(src interface{}) error { return scanGenericObject(O, src) }
+
+func scanGenericObject(dest genericObjectDest, src interface{}) error {
+	rv := reflect.ValueOf(dest.objectDest()).Elem()
+	switch x := src.(type) {
+	case nil:
+		rv.SetZero()
+		return nil
+	case *Object:
+		// The connection is needed only to look up types by struct tags,
+		// which is not needed as the object knows its type.
+		err := (*conn)(nil).dataGetObjectStructObj(context.Background(), rv, x)
+		if closeErr := x.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	default:
+		return fmt.Errorf("scan %T into %T: %w", src, dest, errUnknownType)
+	}
+}
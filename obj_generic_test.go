@@ -0,0 +1,51 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestGenericObjectScanNil(t *testing.T) {
+	coll := Collection[string]{TypeName: "PHONE_TAB", Items: []string{"a"}}
+	if err := coll.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if coll.Items != nil || coll.TypeName != "PHONE_TAB" {
+		t.Errorf("got %#v", coll)
+	}
+
+	type emp struct {
+		Name string `godror:"NAME"`
+		ID   int    `godror:"ID"`
+	}
+	obj := ObjectOf[emp]{TypeName: "EMP_OT", Value: emp{ID: 1, Name: "a"}}
+	if err := obj.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Value != (emp{}) {
+		t.Errorf("got %#v", obj)
+	}
+	if err := obj.Scan("x"); !errors.Is(err, errUnknownType) {
+		t.Errorf("scan string: got %+v, wanted errUnknownType", err)
+	}
+}
+
+func TestGenericObjectValue(t *testing.T) {
+	var g genericObject = Collection[int]{Items: []int{1, 2}}
+	if items, ok := g.objectValue().([]int); !ok || len(items) != 2 {
+		t.Errorf("got %#v", g.objectValue())
+	}
+	if _, err := g.objectType(context.Background(), nil); err == nil {
+		t.Error("wanted error for missing TypeName")
+	}
+	o := &ObjectOf[struct{ A int }]{}
+	if p, ok := o.objectDest().(*struct{ A int }); !ok || p != &o.Value {
+		t.Errorf("got %#v", o.objectDest())
+	}
+}
@@ -14,7 +14,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"math"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -345,6 +348,10 @@ type CompileError struct {
 	Owner, Name, Type, Text string
 	Line, Position, Code    int64
 	Warning                 bool
+	// Facility ("PLS", "PLW" or "ORA") and Message are parsed from Text.
+	Facility, Message string
+	// Source is the offending line of the source, as returned by GetCompileDiagnostics.
+	Source string
 }
 
 func (ce CompileError) Error() string {
@@ -379,6 +386,7 @@ func GetCompileErrors(ctx context.Context, queryer Querier, all bool) ([]Compile
 			return errors, err
 		}
 		ce.Warning = warn == "WARNING"
+		ce.Facility, ce.Message = parseCompileErrorText(ce.Text)
 		if !ce.Warning || all {
 			errors = append(errors, ce)
 		}
@@ -469,6 +477,91 @@ func MapToSlice(qry string, metParam func(string) interface{}) (string, []interf
 	return buf.String(), arr
 }
 
+// StructToOrdered converts the query from named params (:paramname) to :%d placeholders + slice of params,
+// as NamedToOrdered does, but takes the params from the fields of the struct v.
+//
+// The params are matched to the fields by their "godror" or "db" struct tag, or by the field name,
+// case-insensitively and ignoring underscores (as with ScanAll). Fields tagged "-" are skipped.
+// A param without a matching field is an error.
+//
+// If v is a pointer to a struct, then its pointer fields are bound as sql.Out:
+// a nil pointer as OUT (a new value is allocated for it), a non-nil one as IN OUT,
+// so the OUT values are in the struct after Exec (a NULL is returned as the zero value).
+// The other fields, and all fields of a struct passed by value, are bound as IN params
+// (pointers dereferenced, nil as NULL).
+//
+//	type addEmp struct {
+//		Name string `db:"name"`
+//		ID   *int64 `db:"id"`
+//	}
+//	args := addEmp{Name: "Scott"}
+//	qry, params, err := godror.StructToOrdered("BEGIN :id := emp_pkg.add(:name); END;", &args)
+//	if err != nil {
+//		return err
+//	}
+//	if _, err = db.ExecContext(ctx, qry, params...); err != nil {
+//		return err
+//	}
+//	fmt.Println(*args.ID)
+func StructToOrdered(qry string, v interface{}) (string, []interface{}, error) {
+	rv := reflect.ValueOf(v)
+	isPtr := rv.Kind() == reflect.Ptr
+	if isPtr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return qry, nil, fmt.Errorf("bind %T: not a struct: %w", v, errUnknownType)
+	}
+	fields := structFieldsByKey(rv.Type())
+	// The direction of an OUT field is decided once (at its first param),
+	// and it is allocated only when all the params match.
+	type outField string
+	outs := make(map[outField]*sql.Out)
+	var errs []error
+	qry, params := MapToSlice(qry, func(name string) interface{} {
+		key := scanColumnKey(name)
+		idx, ok := fields[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: no matching field in %T", name, v))
+			return nil
+		}
+		f, err := rv.FieldByIndexErr(idx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			return nil
+		}
+		if f.Kind() != reflect.Ptr {
+			return f.Interface()
+		}
+		if !isPtr {
+			if f.IsNil() {
+				return nil
+			}
+			return f.Elem().Interface()
+		}
+		if _, ok := outs[outField(key)]; !ok {
+			outs[outField(key)] = &sql.Out{In: !f.IsNil()}
+		}
+		return outField(key)
+	})
+	if err := errors.Join(errs...); err != nil {
+		return qry, nil, err
+	}
+	for key, out := range outs {
+		f := rv.FieldByIndex(fields[string(key)])
+		if !out.In {
+			f.Set(reflect.New(f.Type().Elem()))
+		}
+		out.Dest = f.Interface()
+	}
+	for i, p := range params {
+		if key, ok := p.(outField); ok {
+			params[i] = *outs[key]
+		}
+	}
+	return qry, params, nil
+}
+
 // EnableDbmsOutput enables DBMS_OUTPUT buffering on the given connection.
 // This is required if you want to retrieve the output with ReadDbmsOutput later.
 //
@@ -569,6 +662,9 @@ type Conn interface {
 	GetObjectType(name string) (*ObjectType, error)
 	NewData(baseType interface{}, SliceLen, BufSize int) ([]*Data, error)
 	NewTempLob(isClob bool) (*DirectLob, error)
+	NewBFile(dir, name string) (*BFile, error)
+	EnqObject(ctx context.Context, queueName string, options EnqOptions, message *Message) ([MsgIDLength]byte, error)
+	DeqObject(ctx context.Context, queueName string, options DeqOptions, message *Message) ([MsgIDLength]byte, error)
 
 	Timezone() *time.Location
 	GetPoolStats() (PoolStats, error)
@@ -769,3 +865,27 @@ func ReplaceQuestionPlacholders(qry string) string {
 	buf.WriteString(qry)
 	return buf.String()
 }
+
+// SplitStringMap returns the keys (in ascending order) and the corresponding values of m,
+// to be bound as PL/SQL index-by tables (with PlSQLArrays) - this is what StringMap does
+// to bind an INDEX BY VARCHAR2 table.
+func SplitStringMap[T any](m map[string]T) ([]string, []T) {
+	keys := slices.Sorted(maps.Keys(m))
+	values := make([]T, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+	return keys, values
+}
+
+// JoinStringMap returns the map of the keys to the values, the inverse of SplitStringMap.
+func JoinStringMap[T any](keys []string, values []T) (map[string]T, error) {
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("got %d keys but %d values", len(keys), len(values))
+	}
+	m := make(map[string]T, len(keys))
+	for i, k := range keys {
+		m[k] = values[i]
+	}
+	return m, nil
+}
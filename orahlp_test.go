@@ -147,3 +147,83 @@ func TestConnPool(t *testing.T) {
 		t.Log("connection should be closed after the pool is closed, but works")
 	}
 }
+
+func TestSplitStringMap(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+	keys, values := godror.SplitStringMap(m)
+	if d := cmp.Diff([]string{"a", "b", "c"}, keys); d != "" {
+		t.Error(d)
+	}
+	if d := cmp.Diff([]int{1, 2, 3}, values); d != "" {
+		t.Error(d)
+	}
+	got, err := godror.JoinStringMap(keys, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(m, got); d != "" {
+		t.Error(d)
+	}
+	if _, err = godror.JoinStringMap(keys, values[1:]); err == nil {
+		t.Error("wanted error for different lengths")
+	}
+}
+
+func TestStructToOrdered(t *testing.T) {
+	type params struct {
+		Name    string `godror:"name"`
+		Dept    *int   `db:"dept_id"`
+		Salary  *float64
+		Ignored string `db:"-"`
+	}
+	dept := 10
+	const qry = "BEGIN :salary := emp_pkg.add(:name, :dept_id); END;"
+
+	// By value: everything is IN.
+	gotQry, got, err := godror.StructToOrdered(qry, params{Name: "Scott", Dept: &dept})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "BEGIN :1 := emp_pkg.add(:2, :3); END;"; gotQry != want {
+		t.Errorf("got %q, wanted %q", gotQry, want)
+	}
+	if d := cmp.Diff([]interface{}{nil, "Scott", 10}, got); d != "" {
+		t.Error(d)
+	}
+
+	// By pointer: pointer fields are OUT (nil) or IN OUT.
+	p := params{Name: "Scott", Dept: &dept}
+	if _, got, err = godror.StructToOrdered(qry, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Salary == nil {
+		t.Fatal("Salary is not allocated")
+	}
+	want := []interface{}{sql.Out{Dest: p.Salary}, "Scott", sql.Out{Dest: &dept, In: true}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("got %#v, wanted %#v", got, want)
+	}
+
+	// A repeated OUT param is the same OUT param.
+	p = params{Name: "Scott"}
+	if _, got, err = godror.StructToOrdered("BEGIN :salary := 1; :salary := :salary + 1; END;", &p); err != nil {
+		t.Fatal(err)
+	}
+	out := sql.Out{Dest: p.Salary}
+	if want := []interface{}{out, out, out}; !reflect.DeepEqual(want, got) {
+		t.Errorf("got %#v, wanted %#v", got, want)
+	}
+
+	if _, _, err = godror.StructToOrdered("SELECT :ignored FROM DUAL", p); err == nil {
+		t.Error("wanted error for unmatched param")
+	}
+	p = params{Name: "Scott"}
+	if _, _, err = godror.StructToOrdered("BEGIN :salary := :unknown; END;", &p); err == nil {
+		t.Error("wanted error for unmatched param")
+	} else if p.Salary != nil {
+		t.Error("Salary is allocated on error")
+	}
+	if _, _, err = godror.StructToOrdered(qry, map[string]interface{}{"name": "x"}); err == nil {
+		t.Error("wanted error for non-struct")
+	}
+}
@@ -0,0 +1,1265 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidOSON is returned when the OSON image cannot be decoded.
+var ErrInvalidOSON = errors.New("invalid OSON image")
+
+const (
+	osonMagic0, osonMagic1, osonMagic2 = 0xff, 0x4a, 0x5a
+
+	osonVersionMaxFName255   = 1
+	osonVersionMaxFName65535 = 3
+
+	// primary flags
+	osonFlagRelOffsetMode   = 0x0001
+	osonFlagInlineLeaf      = 0x0002
+	osonFlagNumFNamesUint32 = 0x0008
+	osonFlagIsScalar        = 0x0010
+	osonFlagHashIDUint8     = 0x0100
+	osonFlagNumFNamesUint16 = 0x0400
+	osonFlagFNamesSegUint32 = 0x0800
+	osonFlagTreeSegUint32   = 0x1000
+	osonFlagTinyNodesStat   = 0x2000
+	// secondary flags
+	osonFlagSecFNamesSegUint16 = 0x0100
+
+	// node types
+	osonNull           = 0x30
+	osonTrue           = 0x31
+	osonFalse          = 0x32
+	osonString8        = 0x33
+	osonNumber8        = 0x34
+	osonBinaryDouble   = 0x36
+	osonString16       = 0x37
+	osonString32       = 0x38
+	osonTimestamp      = 0x39
+	osonBinary16       = 0x3a
+	osonBinary32       = 0x3b
+	osonDate           = 0x3c
+	osonIntervalYM     = 0x3d
+	osonIntervalDS     = 0x3e
+	osonExtended       = 0x7b
+	osonTimestampTZ    = 0x7c
+	osonTimestamp7     = 0x7d
+	osonID             = 0x7e
+	osonBinaryFloat    = 0x7f
+	osonObject         = 0x84
+	osonArray          = 0xc0
+	osonExtendedVector = 0x01
+
+	// osonMaxDepth is the maximal nesting depth, as in the database.
+	osonMaxDepth = 1000
+)
+
+// DecodeOSON decodes the OSON (Oracle's binary JSON format) image in b into a Go value,
+// in pure Go, without a connection.
+// Fetch the image as RAW or BLOB (e.g. from a BLOB column with an IS JSON FORMAT OSON constraint).
+//
+// The values are the same as JSON.GetValue returns:
+//
+//	map[string]interface{}, for JSON objects
+//	[]interface{}, for JSON arrays
+//	godror.Number (JSONOptNumberAsString) or float64 (JSONOptDefault) for NUMBER
+//	float64 for BINARY_DOUBLE, float32 for BINARY_FLOAT
+//	string, bool, nil
+//	[]byte, for RAW
+//	time.Time, for DATE and TIMESTAMP
+//	time.Duration, for INTERVAL DAY TO SECOND
+//	Vector, for VECTOR
+func DecodeOSON(b []byte, opts JSONOption) (interface{}, error) {
+	d := osonDecoder{opts: opts}
+	return d.decode(b)
+}
+
+type osonDecoder struct {
+	tree        []byte
+	names       []string
+	fieldIDSize int
+	relative    bool
+	opts        JSONOption
+	nodes       int
+}
+
+func (d *osonDecoder) decode(b []byte) (interface{}, error) {
+	if err := d.init(b); err != nil {
+		return nil, err
+	}
+	return d.node(0, 0)
+}
+
+// init parses the header and the field names, and sets the tree segment.
+func (d *osonDecoder) init(b []byte) error {
+	r := osonReader{b: b}
+	if m := r.take(3); m != nil && (m[0] != osonMagic0 || m[1] != osonMagic1 || m[2] != osonMagic2) {
+		return fmt.Errorf("bad magic %x: %w", m, ErrInvalidOSON)
+	}
+	version := r.u8()
+	if r.err == nil && version != osonVersionMaxFName255 && version != osonVersionMaxFName65535 {
+		return fmt.Errorf("unknown version %d: %w", version, ErrInvalidOSON)
+	}
+	flags := r.u16()
+	d.relative = flags&osonFlagRelOffsetMode != 0
+	if flags&osonFlagIsScalar != 0 {
+		if flags&osonFlagTreeSegUint32 != 0 {
+			r.take(4)
+		} else {
+			r.take(2)
+		}
+		if r.err != nil {
+			return r.err
+		}
+		d.tree = r.b[r.pos:]
+		return nil
+	}
+
+	var numShort uint32
+	switch {
+	case flags&osonFlagNumFNamesUint32 != 0:
+		numShort, d.fieldIDSize = r.u32(), 4
+	case flags&osonFlagNumFNamesUint16 != 0:
+		numShort, d.fieldIDSize = uint32(r.u16()), 2
+	default:
+		numShort, d.fieldIDSize = uint32(r.u8()), 1
+	}
+	shortOffsetSize, shortSegSize := 2, uint32(0)
+	if flags&osonFlagFNamesSegUint32 != 0 {
+		shortOffsetSize, shortSegSize = 4, r.u32()
+	} else {
+		shortSegSize = uint32(r.u16())
+	}
+	var numLong, longSegSize uint32
+	longOffsetSize := 4
+	if version == osonVersionMaxFName65535 {
+		if r.u16()&osonFlagSecFNamesSegUint16 != 0 {
+			longOffsetSize = 2
+		}
+		numLong, longSegSize = r.u32(), r.u32()
+	}
+	var treeSize uint32
+	if flags&osonFlagTreeSegUint32 != 0 {
+		treeSize = r.u32()
+	} else {
+		treeSize = uint32(r.u16())
+	}
+	r.u16() // number of "tiny" nodes
+	if r.err != nil {
+		return r.err
+	}
+	if uint64(numShort)+uint64(numLong) > uint64(len(b)) {
+		return fmt.Errorf("%d+%d field names in %d bytes: %w", numShort, numLong, len(b), ErrInvalidOSON)
+	}
+	d.names = make([]string, 0, numShort+numLong)
+	if err := d.fieldNames(&r, numShort, 1, shortOffsetSize, shortSegSize); err != nil {
+		return err
+	}
+	if err := d.fieldNames(&r, numLong, 2, longOffsetSize, longSegSize); err != nil {
+		return err
+	}
+	d.tree = r.take(int(treeSize))
+	return r.err
+}
+
+// fieldNames reads a field names segment: the hash ids, the offsets and the names (prefixed with their length).
+func (d *osonDecoder) fieldNames(r *osonReader, num uint32, hashSize, offsetSize int, segSize uint32) error {
+	if num == 0 {
+		return nil
+	}
+	r.take(int(num) * hashSize)
+	offsets := r.take(int(num) * offsetSize)
+	seg := r.take(int(segSize))
+	if r.err != nil {
+		return r.err
+	}
+	for i := 0; i < int(num); i++ {
+		var off int
+		if offsetSize == 2 {
+			off = int(binary.BigEndian.Uint16(offsets[2*i:]))
+		} else {
+			off = int(binary.BigEndian.Uint32(offsets[4*i:]))
+		}
+		nr := osonReader{b: seg, pos: off}
+		var n int
+		if hashSize == 1 {
+			n = int(nr.u8())
+		} else {
+			n = int(nr.u16())
+		}
+		name := nr.take(n)
+		if nr.err != nil {
+			return fmt.Errorf("field name %d: %w", len(d.names)+1, nr.err)
+		}
+		d.names = append(d.names, string(name))
+	}
+	return nil
+}
+
+// node decodes the node at pos in the tree segment.
+func (d *osonDecoder) node(pos, depth int) (interface{}, error) {
+	if err := d.enter(depth); err != nil {
+		return nil, err
+	}
+	r := osonReader{b: d.tree, pos: pos}
+	typ := r.u8()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if typ&0x80 != 0 {
+		return d.container(&r, typ, pos, depth)
+	}
+	var v interface{}
+	switch typ {
+	case osonNull:
+		return nil, nil
+	case osonTrue:
+		return true, nil
+	case osonFalse:
+		return false, nil
+	case osonDate, osonTimestamp7:
+		return osonDecodeTime(r.take(7))
+	case osonTimestamp:
+		return osonDecodeTime(r.take(11))
+	case osonTimestampTZ:
+		return osonDecodeTime(r.take(13))
+	case osonBinaryFloat:
+		if p := r.take(4); p != nil {
+			v = math.Float32frombits(osonCanonical32(binary.BigEndian.Uint32(p), false))
+		}
+	case osonBinaryDouble:
+		if p := r.take(8); p != nil {
+			v = math.Float64frombits(osonCanonical64(binary.BigEndian.Uint64(p), false))
+		}
+	case osonIntervalDS:
+		if p := r.take(11); p != nil {
+			v = osonDecodeIntervalDS(p)
+		}
+	case osonIntervalYM:
+		return nil, fmt.Errorf("INTERVAL YEAR TO MONTH: %w", ErrNotSupported)
+	case osonString8:
+		v = string(r.take(int(r.u8())))
+	case osonString16:
+		v = string(r.take(int(r.u16())))
+	case osonString32:
+		v = string(r.take(int(r.u32())))
+	case osonNumber8:
+		return d.number(r.take(int(r.u8())))
+	case osonID:
+		v = append([]byte(nil), r.take(int(r.u8()))...)
+	case osonBinary16:
+		v = append([]byte{}, r.take(int(r.u16()))...)
+	case osonBinary32:
+		v = append([]byte{}, r.take(int(r.u32()))...)
+	case osonExtended:
+		if ext := r.u8(); r.err == nil && ext != osonExtendedVector {
+			return nil, fmt.Errorf("extended type %#x: %w", ext, ErrNotSupported)
+		}
+		if p := r.take(int(r.u32())); p != nil {
+			return decodeVectorImage(p)
+		}
+	default:
+		switch {
+		case typ&0xf0 == 0x20 || typ&0xf0 == 0x60:
+			// NUMBER with the length in the node type
+			return d.number(r.take(int(typ&0x0f) + 1))
+		case typ&0xf0 == 0x40 || typ&0xf0 == 0x50:
+			// integer with the length in the node type
+			return d.number(r.take(int(typ & 0x0f)))
+		case typ&0xe0 == 0:
+			// short string with the length in the node type
+			v = string(r.take(int(typ)))
+		default:
+			return nil, fmt.Errorf("node type %#x at %d: %w", typ, pos, ErrInvalidOSON)
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return v, nil
+}
+
+func (d *osonDecoder) number(p []byte) (interface{}, error) {
+	if p == nil {
+		return nil, fmt.Errorf("short NUMBER: %w", ErrInvalidOSON)
+	}
+	s, err := oraNumberString(p)
+	if err != nil {
+		return nil, err
+	}
+	if d.opts == JSONOptNumberAsString {
+		return Number(s), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// container decodes an object or array, its type byte already read.
+func (d *osonDecoder) container(r *osonReader, typ byte, start, depth int) (interface{}, error) {
+	c, err := d.containerHeader(r, typ, start)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	var arr []interface{}
+	if c.isObject {
+		m = make(map[string]interface{}, c.num)
+	} else {
+		arr = make([]interface{}, c.num)
+	}
+	for i := 0; i < c.num; i++ {
+		name, pos, err := d.child(c, i)
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.node(pos, depth+1)
+		if err != nil {
+			if c.isObject {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		if c.isObject {
+			m[name] = v
+		} else {
+			arr[i] = v
+		}
+	}
+	if c.isObject {
+		return m, nil
+	}
+	return arr, nil
+}
+
+// enter checks the limits before decoding a node.
+func (d *osonDecoder) enter(depth int) error {
+	// Every node occupies at least one byte, so a valid tree cannot have more nodes than bytes -
+	// this protects against (maliciously) shared subtrees.
+	if d.nodes++; d.nodes > len(d.tree) {
+		return fmt.Errorf("too many nodes: %w", ErrInvalidOSON)
+	}
+	if depth > osonMaxDepth {
+		return fmt.Errorf("nesting deeper than %d: %w", osonMaxDepth, ErrInvalidOSON)
+	}
+	return nil
+}
+
+// osonContainer is the parsed header of an object or array.
+type osonContainer struct {
+	fieldIDs, offsets []byte
+	num               int
+	offsetSize        int
+	start             int
+	isObject          bool
+}
+
+// containerHeader parses the header of an object or array, its type byte already read.
+//
+// The bits of the type byte: 0x40 is set for arrays, 0x20 means 4-byte (instead of 2-byte) child offsets,
+// 0x18 tells the size of the children count (0: 1 byte, 0x08: 2 bytes, 0x10: 4 bytes),
+// or that the object shares the field ids of another object (0x18).
+func (d *osonDecoder) containerHeader(r *osonReader, typ byte, start int) (osonContainer, error) {
+	c := osonContainer{isObject: typ&0x40 == 0, offsetSize: 2, start: start}
+	if c.isObject && d.fieldIDSize == 0 {
+		return c, fmt.Errorf("object in a scalar image: %w", ErrInvalidOSON)
+	}
+	if typ&0x20 != 0 {
+		c.offsetSize = 4
+	}
+	num, shared := osonNumChildren(r, typ)
+	if shared {
+		// the offset of the object whose field ids are used
+		off := r.offset(c.offsetSize)
+		sr := osonReader{b: d.tree, pos: int(off)}
+		styp := sr.u8()
+		if sr.err == nil && (styp&0xc0 != 0x80 || styp&0x18 == 0x18) {
+			return c, fmt.Errorf("shared field ids at %d point to %#x: %w", start, styp, ErrInvalidOSON)
+		}
+		num, _ = osonNumChildren(&sr, styp)
+		if c.fieldIDs = sr.take(int(num) * d.fieldIDSize); sr.err != nil {
+			return c, sr.err
+		}
+	} else if c.isObject {
+		c.fieldIDs = r.take(int(num) * d.fieldIDSize)
+	}
+	c.offsets = r.take(int(num) * c.offsetSize)
+	c.num = int(num)
+	return c, r.err
+}
+
+// child returns the name (for objects) and the position of the i-th child of the container.
+func (d *osonDecoder) child(c osonContainer, i int) (string, int, error) {
+	var pos int
+	if c.offsetSize == 2 {
+		pos = int(binary.BigEndian.Uint16(c.offsets[2*i:]))
+	} else {
+		pos = int(binary.BigEndian.Uint32(c.offsets[4*i:]))
+	}
+	if d.relative {
+		pos += c.start
+	}
+	if !c.isObject {
+		return "", pos, nil
+	}
+	var id int
+	switch d.fieldIDSize {
+	case 1:
+		id = int(c.fieldIDs[i])
+	case 2:
+		id = int(binary.BigEndian.Uint16(c.fieldIDs[2*i:]))
+	default:
+		id = int(binary.BigEndian.Uint32(c.fieldIDs[4*i:]))
+	}
+	if id < 1 || id > len(d.names) {
+		return "", 0, fmt.Errorf("field id %d of %d: %w", id, len(d.names), ErrInvalidOSON)
+	}
+	return d.names[id-1], pos, nil
+}
+
+func osonNumChildren(r *osonReader, typ byte) (num uint32, shared bool) {
+	switch typ & 0x18 {
+	case 0:
+		num = uint32(r.u8())
+	case 0x08:
+		num = uint32(r.u16())
+	case 0x10:
+		num = r.u32()
+	default:
+		return 0, true
+	}
+	// every child needs at least an offset and a node type byte
+	if r.err == nil && uint64(num) > uint64(len(r.b)) {
+		r.err = fmt.Errorf("%d children in %d bytes: %w", num, len(r.b), ErrInvalidOSON)
+		num = 0
+	}
+	return num, false
+}
+
+// osonReader reads big-endian numbers from b, remembering the first error.
+type osonReader struct {
+	err error
+	b   []byte
+	pos int
+}
+
+func (r *osonReader) take(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || r.pos < 0 || len(r.b)-r.pos < n {
+		r.err = fmt.Errorf("need %d bytes at %d of %d: %w", n, r.pos, len(r.b), ErrInvalidOSON)
+		return nil
+	}
+	p := r.b[r.pos : r.pos+n : r.pos+n]
+	r.pos += n
+	return p
+}
+func (r *osonReader) u8() uint8 {
+	if p := r.take(1); p != nil {
+		return p[0]
+	}
+	return 0
+}
+func (r *osonReader) u16() uint16 {
+	if p := r.take(2); p != nil {
+		return binary.BigEndian.Uint16(p)
+	}
+	return 0
+}
+func (r *osonReader) u32() uint32 {
+	if p := r.take(4); p != nil {
+		return binary.BigEndian.Uint32(p)
+	}
+	return 0
+}
+func (r *osonReader) offset(size int) uint32 {
+	if size == 2 {
+		return uint32(r.u16())
+	}
+	return r.u32()
+}
+
+// EncodeOSON encodes v into an OSON image, in pure Go, without allocating dpiJsonNode trees in C.
+// Bind the result as RAW or BLOB.
+//
+// It accepts the same values as JSONValue: nil, bool, numbers, Number, string, []byte,
+// time.Time, time.Duration, Vector, map[string]interface{}, []interface{},
+// and anything JSONMarshal accepts (structs, typed maps and slices).
+//
+// Numbers are encoded as NUMBER (NaN and infinities as BINARY_DOUBLE),
+// time.Time as TIMESTAMP of its wall clock, ignoring the time zone.
+func EncodeOSON(v interface{}) ([]byte, error) {
+	var e osonEncoder
+	return e.encode(v)
+}
+
+type osonEncoder struct {
+	fields      map[string]*osonField
+	tree        []byte
+	fieldIDSize int
+}
+
+type osonField struct {
+	name   string
+	hash   uint32
+	id     uint32
+	offset uint32
+}
+
+func (e *osonEncoder) encode(v interface{}) ([]byte, error) {
+	v, err := osonNormalize(v)
+	if err != nil {
+		return nil, err
+	}
+	flags := uint16(osonFlagInlineLeaf)
+	var short, long []*osonField
+	var shortSeg, longSeg []byte
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		flags |= osonFlagHashIDUint8 | osonFlagTinyNodesStat
+		e.fields = make(map[string]*osonField)
+		e.collectFields(v)
+		for _, f := range e.fields {
+			if len(f.name) > 255 {
+				long = append(long, f)
+			} else {
+				short = append(short, f)
+			}
+		}
+		// the database looks the names up by hash, then by length and name
+		for _, fs := range [][]*osonField{short, long} {
+			sort.Slice(fs, func(i, j int) bool {
+				a, b := fs[i], fs[j]
+				if a.hash&0xff != b.hash&0xff {
+					return a.hash&0xff < b.hash&0xff
+				}
+				if len(a.name) != len(b.name) {
+					return len(a.name) < len(b.name)
+				}
+				return a.name < b.name
+			})
+		}
+		for i, f := range append(short[:len(short):len(short)], long...) {
+			f.id = uint32(i + 1)
+		}
+		for _, f := range short {
+			f.offset = uint32(len(shortSeg))
+			shortSeg = append(shortSeg, byte(len(f.name)))
+			shortSeg = append(shortSeg, f.name...)
+		}
+		for _, f := range long {
+			f.offset = uint32(len(longSeg))
+			longSeg = binary.BigEndian.AppendUint16(longSeg, uint16(len(f.name)))
+			longSeg = append(longSeg, f.name...)
+		}
+		switch n := len(e.fields); {
+		case n > 65535:
+			flags |= osonFlagNumFNamesUint32
+			e.fieldIDSize = 4
+		case n > 255:
+			flags |= osonFlagNumFNamesUint16
+			e.fieldIDSize = 2
+		default:
+			e.fieldIDSize = 1
+		}
+		if len(shortSeg) > 65535 {
+			flags |= osonFlagFNamesSegUint32
+		}
+	default:
+		flags |= osonFlagIsScalar
+	}
+
+	if err := e.node(v); err != nil {
+		return nil, err
+	}
+	if len(e.tree) > 65535 {
+		flags |= osonFlagTreeSegUint32
+	}
+
+	version := byte(osonVersionMaxFName255)
+	if len(long) != 0 {
+		version = osonVersionMaxFName65535
+	}
+	b := make([]byte, 0, 32+len(e.fields)*7+len(shortSeg)+len(longSeg)+len(e.tree))
+	b = append(b, osonMagic0, osonMagic1, osonMagic2, version)
+	b = binary.BigEndian.AppendUint16(b, flags)
+	if flags&osonFlagIsScalar == 0 {
+		b = osonAppendUint(b, e.fieldIDSize, uint32(len(short)))
+		b = osonAppendSize(b, len(shortSeg))
+		if len(long) != 0 {
+			var secondary uint16
+			if len(longSeg) <= 65535 {
+				secondary = osonFlagSecFNamesSegUint16
+			}
+			b = binary.BigEndian.AppendUint16(b, secondary)
+			b = binary.BigEndian.AppendUint32(b, uint32(len(long)))
+			b = binary.BigEndian.AppendUint32(b, uint32(len(longSeg)))
+		}
+	}
+	b = osonAppendSize(b, len(e.tree))
+	if flags&osonFlagIsScalar == 0 {
+		b = append(b, 0, 0) // number of "tiny" nodes
+		b = osonAppendNames(b, short, shortSeg, 1)
+		b = osonAppendNames(b, long, longSeg, 2)
+	}
+	return append(b, e.tree...), nil
+}
+
+// osonAppendNames appends the hash ids, the offsets and the field names segment.
+func osonAppendNames(b []byte, fields []*osonField, seg []byte, hashSize int) []byte {
+	for _, f := range fields {
+		if hashSize == 1 {
+			b = append(b, byte(f.hash))
+		} else {
+			b = binary.BigEndian.AppendUint16(b, uint16(f.hash))
+		}
+	}
+	for _, f := range fields {
+		if len(seg) <= 65535 {
+			b = binary.BigEndian.AppendUint16(b, uint16(f.offset))
+		} else {
+			b = binary.BigEndian.AppendUint32(b, f.offset)
+		}
+	}
+	return append(b, seg...)
+}
+
+func osonAppendSize(b []byte, n int) []byte {
+	if n <= 65535 {
+		return binary.BigEndian.AppendUint16(b, uint16(n))
+	}
+	return binary.BigEndian.AppendUint32(b, uint32(n))
+}
+
+func osonAppendUint(b []byte, size int, n uint32) []byte {
+	switch size {
+	case 1:
+		return append(b, byte(n))
+	case 2:
+		return binary.BigEndian.AppendUint16(b, uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(b, n)
+	}
+}
+
+func (e *osonEncoder) collectFields(v interface{}) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			if _, ok := e.fields[k]; !ok {
+				e.fields[k] = &osonField{name: k, hash: osonHash(k)}
+			}
+			e.collectFields(v)
+		}
+	case []interface{}:
+		for _, v := range x {
+			e.collectFields(v)
+		}
+	}
+}
+
+// osonHash is the 32-bit FNV-1a hash of the field name.
+func osonHash(s string) uint32 {
+	h := uint32(0x811c9dc5)
+	for i := 0; i < len(s); i++ {
+		h = (h ^ uint32(s[i])) * 16777619
+	}
+	return h
+}
+
+// osonNormalize converts v into the types node handles.
+func osonNormalize(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case nil, bool, string, Number, json.Number, []byte, time.Time, time.Duration, Vector,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v, nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, v := range x {
+			var err error
+			if m[k], err = osonNormalize(v); err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+		}
+		return m, nil
+	case []interface{}:
+		arr := make([]interface{}, len(x))
+		for i, v := range x {
+			var err error
+			if arr[i], err = osonNormalize(v); err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		return arr, nil
+	}
+	// structs, pointers, typed slices and maps
+	val, err := jsonEncode(reflect.ValueOf(v))
+	if err != nil {
+		return nil, fmt.Errorf("%T: %w", v, err)
+	}
+	if reflect.TypeOf(val) == reflect.TypeOf(v) {
+		return nil, fmt.Errorf("unsupported type %T", v)
+	}
+	return osonNormalize(val)
+}
+
+func (e *osonEncoder) node(v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		e.tree = append(e.tree, osonNull)
+	case bool:
+		if x {
+			e.tree = append(e.tree, osonTrue)
+		} else {
+			e.tree = append(e.tree, osonFalse)
+		}
+	case string:
+		switch n := len(x); {
+		case n <= 255:
+			e.tree = append(e.tree, osonString8, byte(n))
+		case n <= 65535:
+			e.tree = binary.BigEndian.AppendUint16(append(e.tree, osonString16), uint16(n))
+		default:
+			e.tree = binary.BigEndian.AppendUint32(append(e.tree, osonString32), uint32(n))
+		}
+		e.tree = append(e.tree, x...)
+	case Number:
+		return e.number(string(x))
+	case json.Number:
+		return e.number(string(x))
+	case int:
+		return e.number(strconv.FormatInt(int64(x), 10))
+	case int8:
+		return e.number(strconv.FormatInt(int64(x), 10))
+	case int16:
+		return e.number(strconv.FormatInt(int64(x), 10))
+	case int32:
+		return e.number(strconv.FormatInt(int64(x), 10))
+	case int64:
+		return e.number(strconv.FormatInt(x, 10))
+	case uint:
+		return e.number(strconv.FormatUint(uint64(x), 10))
+	case uint8:
+		return e.number(strconv.FormatUint(uint64(x), 10))
+	case uint16:
+		return e.number(strconv.FormatUint(uint64(x), 10))
+	case uint32:
+		return e.number(strconv.FormatUint(uint64(x), 10))
+	case uint64:
+		return e.number(strconv.FormatUint(x, 10))
+	case float32:
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			e.tree = binary.BigEndian.AppendUint32(append(e.tree, osonBinaryFloat), osonCanonical32(math.Float32bits(x), true))
+			return nil
+		}
+		return e.number(strconv.FormatFloat(float64(x), 'e', -1, 32))
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			e.tree = binary.BigEndian.AppendUint64(append(e.tree, osonBinaryDouble), osonCanonical64(math.Float64bits(x), true))
+			return nil
+		}
+		return e.number(strconv.FormatFloat(x, 'e', -1, 64))
+	case []byte:
+		if len(x) <= 65535 {
+			e.tree = binary.BigEndian.AppendUint16(append(e.tree, osonBinary16), uint16(len(x)))
+		} else {
+			e.tree = binary.BigEndian.AppendUint32(append(e.tree, osonBinary32), uint32(len(x)))
+		}
+		e.tree = append(e.tree, x...)
+	case time.Time:
+		var err error
+		e.tree, err = osonAppendTime(e.tree, x)
+		return err
+	case time.Duration:
+		e.tree = osonAppendIntervalDS(append(e.tree, osonIntervalDS), x)
+	case Vector:
+		img, err := encodeVectorImage(x)
+		if err != nil {
+			return err
+		}
+		e.tree = append(e.tree, osonExtended, osonExtendedVector)
+		e.tree = binary.BigEndian.AppendUint32(e.tree, uint32(len(img)))
+		e.tree = append(e.tree, img...)
+	case []interface{}:
+		offsets := e.container(osonArray, len(x), 0)
+		for i, v := range x {
+			binary.BigEndian.PutUint32(e.tree[offsets+4*i:], uint32(len(e.tree)))
+			if err := e.node(v); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		ids := e.container(osonObject, len(keys), e.fieldIDSize)
+		offsets := ids + len(keys)*e.fieldIDSize
+		for i, k := range keys {
+			id := e.fields[k].id
+			switch e.fieldIDSize {
+			case 1:
+				e.tree[ids+i] = byte(id)
+			case 2:
+				binary.BigEndian.PutUint16(e.tree[ids+2*i:], uint16(id))
+			default:
+				binary.BigEndian.PutUint32(e.tree[ids+4*i:], id)
+			}
+			binary.BigEndian.PutUint32(e.tree[offsets+4*i:], uint32(len(e.tree)))
+			if err := e.node(x[k]); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %T", v)
+	}
+	return nil
+}
+
+// container appends the header of a container with 4-byte offsets,
+// reserving space for the field ids and the offsets of the children.
+//
+// Returns the position of the reserved space.
+func (e *osonEncoder) container(typ byte, num, fieldIDSize int) int {
+	typ |= 0x20
+	switch {
+	case num > 65535:
+		typ |= 0x10
+	case num > 255:
+		typ |= 0x08
+	}
+	e.tree = append(e.tree, typ)
+	switch {
+	case num > 65535:
+		e.tree = binary.BigEndian.AppendUint32(e.tree, uint32(num))
+	case num > 255:
+		e.tree = binary.BigEndian.AppendUint16(e.tree, uint16(num))
+	default:
+		e.tree = append(e.tree, byte(num))
+	}
+	pos := len(e.tree)
+	e.tree = append(e.tree, make([]byte, num*(fieldIDSize+4))...)
+	return pos
+}
+
+func (e *osonEncoder) number(s string) error {
+	p, err := oraNumberBytes(s)
+	if err != nil {
+		return err
+	}
+	e.tree = append(append(e.tree, osonNumber8, byte(len(p))), p...)
+	return nil
+}
+
+// oraNumberBytes returns the Oracle NUMBER representation of the decimal number in s:
+// an exponent byte and at most 20 base-100 digits (see num.OCINum).
+func oraNumberBytes(s string) ([]byte, error) {
+	orig := s
+	var negative bool
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative, s = s[0] == '-', s[1:]
+	}
+	var exp10 int
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", orig, err)
+		}
+		exp10, s = e, s[:i]
+	}
+	// value = 0.digits * 10^exp10
+	digits := make([]byte, 0, len(s)+1)
+	dot := -1
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9':
+			digits = append(digits, c-'0')
+		case c == '.' && dot < 0:
+			dot = len(digits)
+		default:
+			return nil, fmt.Errorf("bad character %q in %q", c, orig)
+		}
+	}
+	if len(digits) == 0 {
+		return nil, fmt.Errorf("no digits in %q", orig)
+	}
+	if dot < 0 {
+		dot = len(digits)
+	}
+	exp10 += dot
+	for len(digits) != 0 && digits[0] == 0 {
+		digits = digits[1:]
+		exp10--
+	}
+	for len(digits) != 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		return []byte{0x80}, nil
+	}
+	if exp10%2 != 0 {
+		digits = append([]byte{0}, digits...)
+		exp10++
+	}
+	if len(digits)%2 != 0 {
+		digits = append(digits, 0)
+	}
+	if len(digits) > 40 {
+		return nil, fmt.Errorf("%q has more than 40 digits", orig)
+	}
+	// value = d1.d2... * 100^exp
+	exp := exp10/2 - 1
+	if exp < -65 || exp > 62 {
+		return nil, fmt.Errorf("%q is out of the range of NUMBER", orig)
+	}
+	p := make([]byte, 1, 2+len(digits)/2)
+	p[0] = byte(exp + 65 + 128)
+	for i := 0; i < len(digits); i += 2 {
+		d := 10*digits[i] + digits[i+1]
+		if negative {
+			p = append(p, 101-d)
+		} else {
+			p = append(p, d+1)
+		}
+	}
+	if negative {
+		p[0] = ^p[0]
+		if len(p) < 21 {
+			p = append(p, 102)
+		}
+	}
+	return p, nil
+}
+
+// oraNumberString returns the decimal representation of the Oracle NUMBER in p.
+func oraNumberString(p []byte) (string, error) {
+	if len(p) == 1 && p[0] == 0x80 {
+		return "0", nil
+	}
+	if len(p) < 2 || len(p) > 22 {
+		return "", fmt.Errorf("NUMBER of %d bytes: %w", len(p), ErrInvalidOSON)
+	}
+	negative := p[0]&0x80 == 0
+	exp := int(p[0] & 0x7f)
+	mantissa := p[1:]
+	if negative {
+		exp = int(^p[0] & 0x7f)
+		if mantissa[len(mantissa)-1] == 102 {
+			mantissa = mantissa[:len(mantissa)-1]
+		}
+	}
+	exp -= 65
+	if len(mantissa) == 0 {
+		return "", fmt.Errorf("NUMBER %x without digits: %w", p, ErrInvalidOSON)
+	}
+	digits := make([]byte, 0, 2*len(mantissa))
+	for _, b := range mantissa {
+		d := int(b) - 1
+		if negative {
+			d = 101 - int(b)
+		}
+		if d < 0 || d > 99 {
+			return "", fmt.Errorf("bad NUMBER digit %d in %x: %w", b, p, ErrInvalidOSON)
+		}
+		digits = append(digits, byte('0'+d/10), byte('0'+d%10))
+	}
+	// the decimal point is after 2*(exp+1) digits
+	point := 2 * (exp + 1)
+	var sb strings.Builder
+	sb.Grow(len(digits) + 4)
+	if negative {
+		sb.WriteByte('-')
+	}
+	switch {
+	case point <= 0:
+		sb.WriteString("0.")
+		sb.WriteString(strings.Repeat("0", -point))
+		sb.Write(digits)
+	case point >= len(digits):
+		sb.Write(digits)
+		sb.WriteString(strings.Repeat("0", point-len(digits)))
+	default:
+		sb.Write(digits[:point])
+		sb.WriteByte('.')
+		sb.Write(digits[point:])
+	}
+	s := sb.String()
+	if strings.IndexByte(s, '.') >= 0 {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	// trim the leading zeros of the integer part
+	sign := ""
+	if negative {
+		sign, s = "-", s[1:]
+	}
+	for len(s) > 1 && s[0] == '0' && s[1] != '.' {
+		s = s[1:]
+	}
+	return sign + s, nil
+}
+
+// osonCanonical64 converts between the IEEE 754 bits of a float64 and Oracle's BINARY_DOUBLE,
+// which sorts bytewise: positive numbers have their sign bit set, negative ones are inverted.
+func osonCanonical64(u uint64, encode bool) uint64 {
+	const sign = 1 << 63
+	if encode {
+		if u&sign == 0 {
+			return u | sign
+		}
+		return ^u
+	}
+	if u&sign != 0 {
+		return u &^ sign
+	}
+	return ^u
+}
+
+// osonCanonical32 is osonCanonical64 for float32 and BINARY_FLOAT.
+func osonCanonical32(u uint32, encode bool) uint32 {
+	const sign = 1 << 31
+	if encode {
+		if u&sign == 0 {
+			return u | sign
+		}
+		return ^u
+	}
+	if u&sign != 0 {
+		return u &^ sign
+	}
+	return ^u
+}
+
+// osonDecodeTime decodes the 7 (DATE), 11 (TIMESTAMP) or 13 (TIMESTAMP WITH TIME ZONE) bytes of p.
+func osonDecodeTime(p []byte) (interface{}, error) {
+	if p == nil {
+		return nil, fmt.Errorf("short DATE: %w", ErrInvalidOSON)
+	}
+	year := (int(p[0])-100)*100 + int(p[1]) - 100
+	var nsec int
+	if len(p) >= 11 {
+		nsec = int(binary.BigEndian.Uint32(p[7:]))
+	}
+	if p[2] < 1 || p[2] > 12 || p[3] < 1 || p[3] > 31 || p[4] < 1 || p[4] > 24 ||
+		p[5] < 1 || p[5] > 60 || p[6] < 1 || p[6] > 60 || nsec > 999999999 {
+		return nil, fmt.Errorf("bad DATE %x: %w", p, ErrInvalidOSON)
+	}
+	if len(p) < 13 {
+		return time.Date(year, time.Month(p[2]), int(p[3]), int(p[4])-1, int(p[5])-1, int(p[6])-1, nsec, time.Local), nil
+	}
+	t := time.Date(year, time.Month(p[2]), int(p[3]), int(p[4])-1, int(p[5])-1, int(p[6])-1, nsec, time.UTC)
+	if p[11]&0x80 != 0 || p[11] == 0 && p[12] == 0 {
+		// region ids are not supported, keep UTC
+		return t, nil
+	}
+	hours, minutes := int(p[11])-20, int(p[12])-60
+	if hours < -15 || hours > 15 || minutes < -59 || minutes > 59 {
+		return nil, fmt.Errorf("bad time zone %x: %w", p[11:], ErrInvalidOSON)
+	}
+	off := hours*3600 + minutes*60
+	if off == 0 {
+		return t, nil
+	}
+	return t.In(time.FixedZone("", off)), nil
+}
+
+// osonAppendTime appends t as TIMESTAMP (or TIMESTAMP7 without fractional seconds).
+func osonAppendTime(b []byte, t time.Time) ([]byte, error) {
+	year := t.Year()
+	if year < -4712 || year > 9999 {
+		return b, fmt.Errorf("year %d is out of range", year)
+	}
+	typ := byte(osonTimestamp)
+	if t.Nanosecond() == 0 {
+		typ = osonTimestamp7
+	}
+	b = append(b, typ,
+		byte(year/100+100), byte(year%100+100), byte(t.Month()), byte(t.Day()),
+		byte(t.Hour()+1), byte(t.Minute()+1), byte(t.Second()+1))
+	if typ == osonTimestamp {
+		b = binary.BigEndian.AppendUint32(b, uint32(t.Nanosecond()))
+	}
+	return b, nil
+}
+
+const osonDurationMid = 0x80000000
+
+func osonDecodeIntervalDS(p []byte) time.Duration {
+	days := int64(binary.BigEndian.Uint32(p)) - osonDurationMid
+	fsecs := int64(binary.BigEndian.Uint32(p[7:])) - osonDurationMid
+	return time.Duration(days)*24*time.Hour +
+		time.Duration(int(p[4])-60)*time.Hour +
+		time.Duration(int(p[5])-60)*time.Minute +
+		time.Duration(int(p[6])-60)*time.Second +
+		time.Duration(fsecs)
+}
+
+func osonAppendIntervalDS(b []byte, dur time.Duration) []byte {
+	days := int64(dur / (24 * time.Hour))
+	dur -= time.Duration(days) * 24 * time.Hour
+	hours := int(dur / time.Hour)
+	dur -= time.Duration(hours) * time.Hour
+	minutes := int(dur / time.Minute)
+	dur -= time.Duration(minutes) * time.Minute
+	seconds := int(dur / time.Second)
+	dur -= time.Duration(seconds) * time.Second
+	b = binary.BigEndian.AppendUint32(b, uint32(days+osonDurationMid))
+	b = append(b, byte(hours+60), byte(minutes+60), byte(seconds+60))
+	return binary.BigEndian.AppendUint32(b, uint32(int64(dur)+osonDurationMid))
+}
+
+const (
+	vectorImageMagic = 0xdb
+
+	vectorImageVersionBase   = 0
+	vectorImageVersionBinary = 1
+	vectorImageVersionSparse = 2
+
+	vectorImageFlagNorm         = 0x0002
+	vectorImageFlagNormReserved = 0x0010
+	vectorImageFlagSparse       = 0x0020
+
+	vectorImageFloat32 = 2
+	vectorImageFloat64 = 3
+	vectorImageInt8    = 4
+	vectorImageBinary  = 5
+)
+
+// decodeVectorImage decodes the VECTOR image (as embedded in OSON).
+func decodeVectorImage(p []byte) (Vector, error) {
+	r := osonReader{b: p}
+	if m := r.u8(); r.err == nil && m != vectorImageMagic {
+		return Vector{}, fmt.Errorf("bad VECTOR magic %#x: %w", m, ErrInvalidOSON)
+	}
+	if version := r.u8(); version > vectorImageVersionSparse {
+		return Vector{}, fmt.Errorf("unknown VECTOR version %d: %w", version, ErrInvalidOSON)
+	}
+	flags := r.u16()
+	format := r.u8()
+	dims := r.u32()
+	if flags&(vectorImageFlagNorm|vectorImageFlagNormReserved) != 0 {
+		r.take(8)
+	}
+	v := Vector{Dimensions: dims}
+	n := int(dims)
+	if format == vectorImageBinary {
+		n = int(dims / 8)
+	}
+	if flags&vectorImageFlagSparse != 0 {
+		v.IsSparse = true
+		n = int(r.u16())
+		if r.err == nil && n > len(p) {
+			return Vector{}, fmt.Errorf("%d sparse elements in %d bytes: %w", n, len(p), ErrInvalidOSON)
+		}
+		v.Indices = make([]uint32, n)
+		for i := range v.Indices {
+			v.Indices[i] = r.u32()
+		}
+	}
+	if r.err == nil && n > len(p) {
+		return Vector{}, fmt.Errorf("%d elements in %d bytes: %w", n, len(p), ErrInvalidOSON)
+	}
+	switch format {
+	case vectorImageFloat32:
+		values := make([]float32, n)
+		for i := range values {
+			values[i] = math.Float32frombits(osonCanonical32(r.u32(), false))
+		}
+		v.Values = values
+	case vectorImageFloat64:
+		values := make([]float64, n)
+		for i := range values {
+			if q := r.take(8); q != nil {
+				values[i] = math.Float64frombits(osonCanonical64(binary.BigEndian.Uint64(q), false))
+			}
+		}
+		v.Values = values
+	case vectorImageInt8:
+		values := make([]int8, n)
+		for i, b := range r.take(n) {
+			values[i] = int8(b)
+		}
+		v.Values = values
+	case vectorImageBinary:
+		v.Values = append([]uint8{}, r.take(n)...)
+	default:
+		return Vector{}, fmt.Errorf("unknown VECTOR format %d: %w", format, ErrInvalidOSON)
+	}
+	if r.err != nil {
+		return Vector{}, r.err
+	}
+	return v, nil
+}
+
+// encodeVectorImage encodes v into a VECTOR image.
+func encodeVectorImage(v Vector) ([]byte, error) {
+	var format byte
+	var n int
+	switch x := v.Values.(type) {
+	case []float32:
+		format, n = vectorImageFloat32, len(x)
+	case []float64:
+		format, n = vectorImageFloat64, len(x)
+	case []int8:
+		format, n = vectorImageInt8, len(x)
+	case []uint8:
+		format, n = vectorImageBinary, len(x)
+	default:
+		return nil, fmt.Errorf("unsupported VECTOR values %T", v.Values)
+	}
+	version, flags := byte(vectorImageVersionBase), uint16(vectorImageFlagNormReserved)
+	if format == vectorImageBinary {
+		version = vectorImageVersionBinary
+	} else {
+		flags |= vectorImageFlagNorm
+	}
+	dims := uint32(v.Len())
+	if v.sparse() {
+		if len(v.Indices) != n {
+			return nil, errors.New("sparse vector indices and values differ in length")
+		}
+		version, flags = vectorImageVersionSparse, flags|vectorImageFlagSparse
+	}
+	b := make([]byte, 0, 17+4*len(v.Indices)+8*n)
+	b = append(b, vectorImageMagic, version)
+	b = binary.BigEndian.AppendUint16(b, flags)
+	b = append(b, format)
+	b = binary.BigEndian.AppendUint32(b, dims)
+	b = append(b, make([]byte, 8)...) // norm
+	if v.sparse() {
+		b = binary.BigEndian.AppendUint16(b, uint16(n))
+		for _, i := range v.Indices {
+			b = binary.BigEndian.AppendUint32(b, i)
+		}
+	}
+	switch x := v.Values.(type) {
+	case []float32:
+		for _, f := range x {
+			b = binary.BigEndian.AppendUint32(b, osonCanonical32(math.Float32bits(f), true))
+		}
+	case []float64:
+		for _, f := range x {
+			b = binary.BigEndian.AppendUint64(b, osonCanonical64(math.Float64bits(f), true))
+		}
+	case []int8:
+		for _, i := range x {
+			b = append(b, byte(i))
+		}
+	case []uint8:
+		b = append(b, x...)
+	}
+	return b, nil
+}
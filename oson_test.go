@@ -0,0 +1,306 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func osonTestDoc() map[string]interface{} {
+	return map[string]interface{}{
+		"id":      Number("42"),
+		"name":    "Árvíztűrő tükörfúrógép",
+		"empty":   "",
+		"long":    strings.Repeat("x", 300),
+		"ok":      true,
+		"nok":     false,
+		"nothing": nil,
+		"price":   Number("-1234.5678"),
+		"tiny":    Number("0.000001"),
+		"huge":    Number("12345678901234567890123456789012345678"),
+		"ts":      time.Date(2026, 10, 18, 12, 30, 45, 123456789, time.Local),
+		"date":    time.Date(1848, 3, 15, 0, 0, 0, 0, time.Local),
+		"wait":    -(26*time.Hour + 3*time.Minute + 4*time.Second + 5),
+		"raw":     []byte{0, 1, 2, 0xff},
+		"items": []interface{}{
+			map[string]interface{}{"sku": "a-1", "qty": Number("3")},
+			map[string]interface{}{"sku": "b-2", "qty": Number("-7"), "note": nil},
+			[]interface{}{},
+			map[string]interface{}{},
+		},
+		"vec":                    Vector{Dimensions: 3, Values: []float32{1.5, -2, 0}},
+		"sparse":                 Vector{Dimensions: 10, Indices: []uint32{1, 7}, Values: []float64{0.25, -8}, IsSparse: true},
+		"bits":                   Vector{Dimensions: 16, Values: []uint8{0xf0, 0x0f}},
+		strings.Repeat("k", 256): Number("1"),
+	}
+}
+
+func TestOSONRoundTrip(t *testing.T) {
+	for name, v := range map[string]interface{}{
+		"doc":     osonTestDoc(),
+		"array":   []interface{}{Number("1"), "two", []interface{}{nil, true}},
+		"string":  "scalar",
+		"number":  Number("3.14"),
+		"null":    nil,
+		"many":    osonTestMany(300),
+		"manyMap": map[string]interface{}{"m": osonTestManyFields(70000)},
+	} {
+		b, err := EncodeOSON(v)
+		if err != nil {
+			t.Fatalf("%s: %+v", name, err)
+		}
+		got, err := DecodeOSON(b, JSONOptNumberAsString)
+		if err != nil {
+			t.Fatalf("%s: %+v", name, err)
+		}
+		if !reflect.DeepEqual(got, v) {
+			t.Errorf("%s: got\n%#v\nwanted\n%#v", name, got, v)
+		}
+	}
+}
+
+func osonTestMany(n int) []interface{} {
+	arr := make([]interface{}, n)
+	for i := range arr {
+		arr[i] = Number(fmt.Sprintf("%d", i))
+	}
+	return arr
+}
+
+func osonTestManyFields(n int) map[string]interface{} {
+	m := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		m[fmt.Sprintf("f%d", i)] = i%2 == 0
+	}
+	return m
+}
+
+func TestOSONGoValues(t *testing.T) {
+	type item struct {
+		SKU   string  `json:"sku"`
+		Price float64 `json:"price"`
+		Qty   int     `json:"qty,omitempty"`
+	}
+	b, err := EncodeOSON(map[string]interface{}{
+		"items": []item{{SKU: "a", Price: 1.25, Qty: 2}, {SKU: "b", Price: 1e-3}},
+		"i8":    int8(-8), "u64": uint64(math.MaxUint64), "f32": float32(0.1),
+		"nan": math.NaN(), "inf": float32(math.Inf(-1)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeOSON(b, JSONOptDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := got.(map[string]interface{})
+	want := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "a", "price": 1.25, "qty": 2.0},
+			map[string]interface{}{"sku": "b", "price": 1e-3},
+		},
+		"i8": -8.0, "u64": float64(math.MaxUint64), "f32": 0.1,
+		"inf": float32(math.Inf(-1)),
+	}
+	if f, ok := m["nan"].(float64); !ok || !math.IsNaN(f) {
+		t.Errorf("nan: got %#v", m["nan"])
+	}
+	delete(m, "nan")
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("got\n%#v\nwanted\n%#v", m, want)
+	}
+
+	if _, err = EncodeOSON(make(chan int)); err == nil {
+		t.Error("chan encoded")
+	}
+}
+
+func TestOSONImage(t *testing.T) {
+	// {"a":1}
+	want := []byte{
+		0xff, 0x4a, 0x5a, 0x01, // magic, version
+		0x21, 0x02, // flags: hash id uint8, tiny nodes stat, inline leaf
+		0x01,       // number of field names
+		0x00, 0x02, // field names segment size
+		0x00, 0x0b, // tree segment size
+		0x00, 0x00, // tiny nodes
+		byte(osonHash("a")), // hash id
+		0x00, 0x00,          // offset of the field name
+		0x01, 'a', // field name
+		0xa4, 0x01, // object, 1 child
+		0x01,                   // field id
+		0x00, 0x00, 0x00, 0x07, // offset of the value
+		0x34, 0x02, 0xc1, 0x02, // NUMBER 1
+	}
+	got, err := EncodeOSON(map[string]interface{}{"a": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got\n%x\nwanted\n%x", got, want)
+	}
+
+	// Relative offsets, 2-byte offsets and inline numbers and strings, as the database writes them.
+	img := []byte{
+		0xff, 0x4a, 0x5a, 0x01,
+		0x21, 0x03, // flags: relative offsets
+		0x02,
+		0x00, 0x04,
+		0x00, 0x16,
+		0x00, 0x00,
+		byte(osonHash("a")), byte(osonHash("b")),
+		0x00, 0x00, 0x00, 0x02,
+		0x01, 'a', 0x01, 'b',
+		0x84, 0x02, // object, 2 children, 2-byte offsets
+		0x01, 0x02,
+		0x00, 0x08, 0x00, 0x0b, // relative offsets
+		0x21, 0xc1, 0x02, // NUMBER 1, length in the node type
+		0xc0, 0x02, // array, 2 children, 2-byte offsets
+		0x00, 0x06, 0x00, 0x08, // relative offsets
+		0x01, 'x', // "x", length in the node type
+		0x98, 0x00, 0x00, // object sharing the field ids of the first one
+		0x00, 0x07, 0x00, 0x07, // relative offsets
+		0x01, 'y',
+	}
+	img[10] = byte(len(img) - 23)
+	v, err := DecodeOSON(img, JSONOptNumberAsString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantV := map[string]interface{}{"a": Number("1"), "b": []interface{}{"x", map[string]interface{}{"a": "y", "b": "y"}}}
+	if !reflect.DeepEqual(v, wantV) {
+		t.Errorf("got %#v, wanted %#v", v, wantV)
+	}
+}
+
+func TestOraNumber(t *testing.T) {
+	for s, want := range map[string][]byte{
+		"0":       {0x80},
+		"1":       {0xc1, 0x02},
+		"-5":      {0x3e, 0x60, 0x66},
+		"100":     {0xc2, 0x02},
+		"0.001":   {0xbf, 0x0b},
+		"123.456": {0xc2, 0x02, 0x18, 0x2e, 0x3d},
+		"-99999999999999999999999999999999999999":  append(append([]byte{0x2c}, bytes.Repeat([]byte{0x02}, 19)...), 0x66),
+		"9999999999999999999999999999999999999999": append([]byte{0xd4}, bytes.Repeat([]byte{0x64}, 20)...),
+	} {
+		if p, err := oraNumberBytes(s); err != nil {
+			t.Fatalf("%s: %+v", s, err)
+		} else if !bytes.Equal(p, want) {
+			t.Errorf("%s: got %x, wanted %x", s, p, want)
+		}
+	}
+	for _, s := range []string{
+		"0", "1", "-1", "5", "-5", "10", "100", "0.1", "0.01", "0.001", "-0.001", "123.456", "-123.456",
+		"1234567890123456789012345678901234567", "-99999999999999999999999999999999999999",
+		"0.000000000000000000000000000001",
+	} {
+		p, err := oraNumberBytes(s)
+		if err != nil {
+			t.Fatalf("%s: %+v", s, err)
+		}
+		if got, err := oraNumberString(p); err != nil {
+			t.Errorf("%s: %+v", s, err)
+		} else if got != s {
+			t.Errorf("%s: got %s", s, got)
+		}
+	}
+	for s, want := range map[string]string{
+		"1e3": "1000", "-2.5E-3": "-0.0025", "+007.50": "7.5", "1.5e125": "15" + strings.Repeat("0", 124),
+	} {
+		p, err := oraNumberBytes(s)
+		if err != nil {
+			t.Fatalf("%s: %+v", s, err)
+		}
+		if got, err := oraNumberString(p); err != nil || got != want {
+			t.Errorf("%s: got %s (%v), wanted %s", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "-", "1.2.3", "x", "1e200", "1e-200", strings.Repeat("1", 41)} {
+		if p, err := oraNumberBytes(s); err == nil {
+			t.Errorf("%q: got %v", s, p)
+		}
+	}
+}
+
+func TestOSONInvalid(t *testing.T) {
+	good, err := EncodeOSON(osonTestDoc())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(good); i++ {
+		if _, err := DecodeOSON(good[:i], JSONOptDefault); !errors.Is(err, ErrInvalidOSON) {
+			t.Fatalf("%d: got %v", i, err)
+		}
+	}
+	// self-referencing array
+	loop := []byte{0xff, 0x4a, 0x5a, 0x01, 0x00, 0x12, 0x00, 0x06, 0xc0, 0x01, 0x00, 0x00}
+	if _, err := DecodeOSON(loop, JSONOptDefault); !errors.Is(err, ErrInvalidOSON) {
+		t.Errorf("loop: got %v", err)
+	}
+}
+
+func FuzzDecodeOSON(f *testing.F) {
+	for _, v := range []interface{}{osonTestDoc(), []interface{}{"a", Number("1")}, "x", nil} {
+		b, err := EncodeOSON(v)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		v, err := DecodeOSON(b, JSONOptNumberAsString)
+		if err != nil {
+			return
+		}
+		// whatever decodes, must survive a round trip (except NaN)
+		b2, err := EncodeOSON(v)
+		if err != nil {
+			if strings.Contains(err.Error(), "year") || strings.Contains(err.Error(), "VECTOR") {
+				return
+			}
+			t.Fatalf("encode %#v: %+v", v, err)
+		}
+		v2, err := DecodeOSON(b2, JSONOptNumberAsString)
+		if err != nil {
+			t.Fatalf("decode %x: %+v", b2, err)
+		}
+		if s, s2 := fmt.Sprintf("%v", v), fmt.Sprintf("%v", v2); s != s2 && !strings.Contains(s, "NaN") {
+			t.Fatalf("got %s, wanted %s", s2, s)
+		}
+	})
+}
+
+func BenchmarkEncodeOSON(b *testing.B) {
+	doc := osonTestDoc()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := EncodeOSON(doc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeOSON(b *testing.B) {
+	img, err := EncodeOSON(osonTestDoc())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(img)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := DecodeOSON(img, JSONOptNumberAsString); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
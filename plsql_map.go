@@ -0,0 +1,355 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StringMap is a map bound as the TypeName INDEX BY VARCHAR2 table type in PL/SQL blocks.
+//
+// OCI can bind only associative arrays indexed by PLS_INTEGER, so Exec wraps the PL/SQL block
+// in another one, which binds the keys and the values as PL/SQL index-by tables (see SplitStringMap),
+// loads them into a local TypeName variable used in place of the placeholder,
+// and reads that back for OUT parameters. The whole call is executed with PlSQLArrays.
+//
+// T must be a string, Number, number, bool, time.Time or []byte.
+// Use a *StringMap for OUT and IN OUT parameters; at most ArraySize (DefaultArraySize) elements are returned:
+//
+//	m := godror.StringMap[int]{TypeName: "MY_PKG.NUM_BY_NAME_T", Map: map[string]int{"a": 1}}
+//	_, err := db.ExecContext(ctx, "BEGIN my_pkg.double(:1); END;", sql.Out{Dest: &m, In: true})
+type StringMap[T any] struct {
+	// TypeName of the INDEX BY VARCHAR2 table type, such as "MY_PKG.NUM_BY_NAME_T".
+	TypeName string
+	Map      map[string]T
+}
+
+// stringMap is implemented by StringMap, to be bound by execStringMaps.
+type stringMap interface {
+	stringMapTypeName() string
+	stringMapElem() reflect.Type
+	// stringMapArrays returns pointers to the keys and the values (*[]T), with at least the given capacity.
+	stringMapArrays(capacity int) (*[]string, interface{})
+}
+
+// stringMapDest is implemented by *StringMap.
+type stringMapDest interface {
+	stringMap
+	// setStringMapArrays sets the map from the keys and values returned by stringMapArrays.
+	setStringMapArrays(keys *[]string, values interface{}) error
+}
+
+var _ stringMapDest = (*StringMap[string])(nil)
+
+func (m StringMap[T]) stringMapTypeName() string   { return m.TypeName }
+func (m StringMap[T]) stringMapElem() reflect.Type { return reflect.TypeFor[T]() }
+func (m StringMap[T]) stringMapArrays(capacity int) (*[]string, interface{}) {
+	keys, values := SplitStringMap(m.Map)
+	keys = slices.Grow(keys, max(0, capacity-len(keys)))
+	values = slices.Grow(values, max(0, capacity-len(values)))
+	return &keys, &values
+}
+func (m *StringMap[T]) setStringMapArrays(keys *[]string, values interface{}) error {
+	joined, err := JoinStringMap(*keys, *(values.(*[]T)))
+	if err != nil {
+		return err
+	}
+	m.Map = joined
+	return nil
+}
+
+// asStringMap returns the StringMap of the arg, and whether it is OUT and IN.
+func asStringMap(a driver.NamedValue) (sm stringMap, isIn, isOut bool, err error) {
+	value, isIn := a.Value, true
+	if out, ok := value.(sql.Out); ok {
+		value, isIn, isOut = out.Dest, out.In, true
+	}
+	sm, ok := value.(stringMap)
+	if !ok {
+		return nil, false, false, nil
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil, false, false, fmt.Errorf("%T is nil", value)
+	}
+	if _, ok := value.(stringMapDest); isOut && !ok {
+		return nil, false, false, fmt.Errorf("OUT %T: must be a pointer", value)
+	}
+	return sm, isIn, isOut, nil
+}
+
+// hasStringMap reports whether any of the args is a StringMap.
+func hasStringMap(args []driver.NamedValue) bool {
+	for _, a := range args {
+		if sm, _, _, err := asStringMap(a); sm != nil || err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// execStringMaps executes the statement wrapped in a PL/SQL block that binds the StringMap args
+// as keys and values PL/SQL arrays, and sets the OUT StringMaps from them after the call.
+func (st *statement) execStringMaps(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	qry, wArgs, setOuts, err := wrapStringMaps(st.query, args, st.ArraySize())
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := st.conn.PrepareContext(ctx, qry)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	wst := stmt.(*statement)
+	wst.stmtOptions = st.stmtOptions
+	wst.plSQLArrays = true
+	res, err := wst.ExecContext(ctx, wArgs)
+	if err != nil {
+		return res, err
+	}
+	return res, setOuts()
+}
+
+var rPlSQLBlock = regexp.MustCompile(`(?is)^(?:\s+|--[^\n]*\n|/\*.*?\*/)*(?:<<|DECLARE\b|BEGIN\b)`)
+
+// wrapStringMaps returns the PL/SQL block wrapping qry, with the StringMap args replaced by the
+// keys and values arrays, and the function setting the OUT StringMaps from those.
+//
+// All the args are bound by name in the wrapper, positional args get the names
+// of the placeholders in order of their first appearance (as Oracle binds them).
+func wrapStringMaps(qry string, args []driver.NamedValue, arraySize int) (string, []driver.NamedValue, func() error, error) {
+	if !rPlSQLBlock.MatchString(qry) {
+		return "", nil, nil, fmt.Errorf("StringMap can only be bound in PL/SQL blocks: %w", ErrNotSupported)
+	}
+	var names []string
+	seen := make(map[string]struct{})
+	forEachPlaceholder(qry, func(_, _ int, name string) {
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	})
+	named := slices.ContainsFunc(args, func(a driver.NamedValue) bool { return a.Name != "" })
+
+	var decl, load, store strings.Builder
+	locals := make(map[string]string)
+	var sets []func() error
+	wArgs := make([]driver.NamedValue, 0, len(args)+2)
+	for i, a := range args {
+		name := a.Name
+		if name == "" {
+			if named {
+				name = strconv.Itoa(a.Ordinal)
+			} else if a.Ordinal < 1 || a.Ordinal > len(names) {
+				return "", nil, nil, fmt.Errorf("%d. arg: no such placeholder in %q", a.Ordinal, qry)
+			} else {
+				name = names[a.Ordinal-1]
+			}
+		}
+		sm, isIn, isOut, err := asStringMap(a)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("%d. arg: %w", i+1, err)
+		}
+		if sm == nil {
+			wArgs = append(wArgs, driver.NamedValue{Name: name, Value: a.Value})
+			continue
+		}
+		if sm.stringMapTypeName() == "" {
+			return "", nil, nil, fmt.Errorf("%d. arg: StringMap has no TypeName", i+1)
+		}
+		elem, err := plsqlElemType(sm.stringMapElem())
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("%d. arg: %w", i+1, err)
+		}
+		key := strings.ToUpper(name)
+		if _, ok := seen[key]; !ok {
+			return "", nil, nil, fmt.Errorf("%d. arg: no placeholder %q in %q", i+1, name, qry)
+		}
+		n, local := i+1, fmt.Sprintf("godror_map_%d", i+1)
+		locals[key] = local
+		fmt.Fprintf(&decl, `  TYPE godror_values_%[1]d_t IS TABLE OF %[2]s INDEX BY PLS_INTEGER;
+  %[3]s %[4]s;
+  godror_keys_%[1]d godror_keys_t;
+  godror_values_%[1]d godror_values_%[1]d_t;
+`, n, elem, local, sm.stringMapTypeName())
+		if isIn {
+			fmt.Fprintf(&load, `  godror_keys_%[1]d := :godror_keys_%[1]d;
+  godror_values_%[1]d := :godror_values_%[1]d;
+  FOR i IN 1..godror_keys_%[1]d.COUNT LOOP
+    %[2]s(godror_keys_%[1]d(i)) := godror_values_%[1]d(i);
+  END LOOP;
+`, n, local)
+		}
+		capacity := 0
+		if isOut {
+			capacity = arraySize
+		}
+		keys, values := sm.stringMapArrays(capacity)
+		keysName, valuesName := fmt.Sprintf("godror_keys_%d", n), fmt.Sprintf("godror_values_%d", n)
+		if !isOut {
+			wArgs = append(wArgs,
+				driver.NamedValue{Name: keysName, Value: *keys},
+				driver.NamedValue{Name: valuesName, Value: reflect.ValueOf(values).Elem().Interface()},
+			)
+			continue
+		}
+		fmt.Fprintf(&store, `  godror_keys_%[1]d.DELETE;
+  godror_values_%[1]d.DELETE;
+  godror_key := %[2]s.FIRST;
+  WHILE godror_key IS NOT NULL LOOP
+    godror_keys_%[1]d(godror_keys_%[1]d.COUNT + 1) := godror_key;
+    godror_values_%[1]d(godror_values_%[1]d.COUNT + 1) := %[2]s(godror_key);
+    godror_key := %[2]s.NEXT(godror_key);
+  END LOOP;
+  :godror_keys_%[1]d := godror_keys_%[1]d;
+  :godror_values_%[1]d := godror_values_%[1]d;
+`, n, local)
+		wArgs = append(wArgs,
+			driver.NamedValue{Name: keysName, Value: sql.Out{Dest: keys, In: isIn}},
+			driver.NamedValue{Name: valuesName, Value: sql.Out{Dest: values, In: isIn}},
+		)
+		dest := sm.(stringMapDest)
+		sets = append(sets, func() error {
+			if err := dest.setStringMapArrays(keys, values); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			return nil
+		})
+	}
+
+	var buf strings.Builder
+	buf.WriteString(`DECLARE
+  TYPE godror_keys_t IS TABLE OF VARCHAR2(32767) INDEX BY PLS_INTEGER;
+  godror_key VARCHAR2(32767);
+`)
+	buf.WriteString(decl.String())
+	buf.WriteString("BEGIN\n")
+	buf.WriteString(load.String())
+	var last int
+	forEachPlaceholder(qry, func(start, end int, name string) {
+		if local, ok := locals[name]; ok {
+			buf.WriteString(qry[last:start])
+			buf.WriteString(local)
+			last = end
+		}
+	})
+	buf.WriteString(strings.TrimSpace(qry[last:]))
+	buf.WriteByte('\n')
+	buf.WriteString(store.String())
+	buf.WriteString("END;")
+
+	return buf.String(), wArgs, func() error {
+		var errs []error
+		for _, set := range sets {
+			if err := set(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// plsqlElemType returns the PL/SQL type of the PL/SQL array elements bound for t.
+func plsqlElemType(t reflect.Type) (string, error) {
+	switch t {
+	case reflect.TypeFor[Number]():
+		return "NUMBER", nil
+	case reflect.TypeFor[time.Time]():
+		return "DATE", nil
+	case reflect.TypeFor[[]byte]():
+		return "RAW(32767)", nil
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "VARCHAR2(32767)", nil
+	case reflect.Bool:
+		return "BOOLEAN", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "NUMBER", nil
+	}
+	return "", fmt.Errorf("StringMap of %s: %w", t, errUnknownType)
+}
+
+// forEachPlaceholder calls fn with the position and the (uppercased, if not numeric) name of
+// each bind placeholder in the PL/SQL block, skipping string literals, quoted identifiers and comments.
+func forEachPlaceholder(qry string, fn func(start, end int, name string)) {
+	isNameChar := func(c byte) bool {
+		return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_' || c == '$' || c == '#'
+	}
+	for i := 0; i < len(qry); i++ {
+		switch c := qry[i]; {
+		case c == '-' && strings.HasPrefix(qry[i:], "--"):
+			if j := strings.IndexByte(qry[i:], '\n'); j >= 0 {
+				i += j
+			} else {
+				return
+			}
+		case c == '/' && strings.HasPrefix(qry[i:], "/*"):
+			if j := strings.Index(qry[i+2:], "*/"); j >= 0 {
+				i += 2 + j + 1
+			} else {
+				return
+			}
+		case c == '"':
+			if j := strings.IndexByte(qry[i+1:], '"'); j >= 0 {
+				i += 1 + j
+			} else {
+				return
+			}
+		case c == '\'':
+			// q'[...]' alternative quoting
+			if i > 0 && (qry[i-1] == 'q' || qry[i-1] == 'Q') && (i == 1 || !isNameChar(qry[i-2]) || qry[i-2] == 'n' || qry[i-2] == 'N') && i+1 < len(qry) {
+				closing := qry[i+1]
+				switch closing {
+				case '[':
+					closing = ']'
+				case '{':
+					closing = '}'
+				case '(':
+					closing = ')'
+				case '<':
+					closing = '>'
+				}
+				if j := strings.Index(qry[i+2:], string([]byte{closing, '\''})); j >= 0 {
+					i += 2 + j + 1
+				} else {
+					return
+				}
+				continue
+			}
+			for i++; i < len(qry); i++ {
+				if qry[i] == '\'' {
+					if i+1 < len(qry) && qry[i+1] == '\'' {
+						i++
+						continue
+					}
+					break
+				}
+			}
+		case c == ':':
+			j := i + 1
+			for j < len(qry) && isNameChar(qry[j]) {
+				j++
+			}
+			if j == i+1 {
+				continue
+			}
+			fn(i, j, strings.ToUpper(qry[i+1:j]))
+			i = j - 1
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestForEachPlaceholder(t *testing.T) {
+	t.Parallel()
+	const qry = `BEGIN -- :comment
+  x := ':lit''s :x' || q'[:q]' || "A:B" /* :c */;
+  pkg.p(:1, :Name, p_x=>:name, :2);
+END;`
+	var got []string
+	forEachPlaceholder(qry, func(start, end int, name string) {
+		got = append(got, name+"="+qry[start:end])
+	})
+	if d := cmp.Diff([]string{"1=:1", "NAME=:Name", "NAME=:name", "2=:2"}, got); d != "" {
+		t.Error(d)
+	}
+}
+
+func TestWrapStringMaps(t *testing.T) {
+	t.Parallel()
+	out := StringMap[int]{TypeName: "pkg.num_by_name_t", Map: map[string]int{"b": 2, "a": 1}}
+	qry, args, setOuts, err := wrapStringMaps(
+		"BEGIN pkg.p(:x, :m); END;",
+		[]driver.NamedValue{
+			{Ordinal: 1, Value: "x"},
+			{Ordinal: 2, Value: sql.Out{Dest: &out, In: true}},
+		}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"godror_map_2 pkg.num_by_name_t;",
+		"TYPE godror_values_2_t IS TABLE OF NUMBER INDEX BY PLS_INTEGER;",
+		"godror_keys_2 := :godror_keys_2;",
+		"BEGIN pkg.p(:x, godror_map_2); END;",
+		":godror_values_2 := godror_values_2;",
+	} {
+		if !strings.Contains(qry, want) {
+			t.Errorf("%q not in %s", want, qry)
+		}
+	}
+	if len(args) != 3 || args[0].Name != "X" || args[1].Name != "godror_keys_2" || args[2].Name != "godror_values_2" {
+		t.Fatalf("got %#v", args)
+	}
+	keys := args[1].Value.(sql.Out).Dest.(*[]string)
+	values := args[2].Value.(sql.Out).Dest.(*[]int)
+	if d := cmp.Diff([]string{"a", "b"}, *keys); d != "" {
+		t.Error(d)
+	}
+	if cap(*keys) < 10 || cap(*values) < 10 {
+		t.Errorf("capacity: got %d, %d, wanted at least 10", cap(*keys), cap(*values))
+	}
+	*keys, *values = append((*keys)[:0], "c"), append((*values)[:0], 3)
+	if err = setOuts(); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(map[string]int{"c": 3}, out.Map); d != "" {
+		t.Error(d)
+	}
+
+	in := StringMap[string]{TypeName: "pkg.str_by_name_t", Map: map[string]string{"a": "A"}}
+	qry, args, _, err = wrapStringMaps("BEGIN pkg.p(:1); END;", []driver.NamedValue{{Ordinal: 1, Value: in}}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(qry, ":godror_keys_1 :=") {
+		t.Errorf("IN map is read back: %s", qry)
+	}
+	if d := cmp.Diff([]driver.NamedValue{
+		{Name: "godror_keys_1", Value: []string{"a"}},
+		{Name: "godror_values_1", Value: []string{"A"}},
+	}, args); d != "" {
+		t.Error(d)
+	}
+
+	for name, tC := range map[string]struct {
+		qry  string
+		args []driver.NamedValue
+	}{
+		"not a block": {"SELECT :1 FROM DUAL", []driver.NamedValue{{Ordinal: 1, Value: in}}},
+		"no TypeName": {"BEGIN p(:1); END;", []driver.NamedValue{{Ordinal: 1, Value: StringMap[int]{}}}},
+		"bad element": {"BEGIN p(:1); END;", []driver.NamedValue{{Ordinal: 1, Value: StringMap[struct{}]{TypeName: "t"}}}},
+		"not pointer": {"BEGIN p(:1); END;", []driver.NamedValue{{Ordinal: 1, Value: sql.Out{Dest: in}}}},
+		"no such":     {"BEGIN p(:a); END;", []driver.NamedValue{{Name: "b", Value: in}}},
+	} {
+		if _, _, _, err := wrapStringMaps(tC.qry, tC.args, 10); err == nil {
+			t.Errorf("%s: wanted error", name)
+		} else if name == "not a block" && !errors.Is(err, ErrNotSupported) {
+			t.Errorf("%s: got %v, wanted ErrNotSupported", name, err)
+		}
+	}
+	if !hasStringMap([]driver.NamedValue{{Value: 1}, {Value: sql.Out{Dest: &out}}}) {
+		t.Error("hasStringMap: missed the OUT StringMap")
+	}
+	if hasStringMap([]driver.NamedValue{{Value: 1}, {Value: map[string]int{}}}) {
+		t.Error("hasStringMap: plain map")
+	}
+}
@@ -371,6 +371,109 @@ func (Q *Queue) Enqueue(messages []Message) error {
 	return nil
 }
 
+// EnqObject enqueues the message (with an Object payload) to the named queue,
+// without creating a Queue, using dpiConn_enqObject.
+//
+// Returns the message ID, which is also written into message.MsgID.
+func (c *conn) EnqObject(ctx context.Context, queueName string, options EnqOptions, message *Message) ([MsgIDLength]byte, error) {
+	if message == nil || message.Object == nil {
+		return zeroMsgID, errors.New("EnqObject needs a message with an Object payload")
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cleanup, err := c.handleDeadline(ctx)
+	if err != nil {
+		return zeroMsgID, err
+	}
+	defer cleanup()
+
+	var opts *C.dpiEnqOptions
+	if C.dpiConn_newEnqOptions(c.dpiConn, &opts) == C.DPI_FAILURE {
+		return zeroMsgID, fmt.Errorf("newEnqOptions: %w", c.getError())
+	}
+	defer C.dpiEnqOptions_release(opts)
+	if err := options.toOra(c.drv, opts); err != nil {
+		return zeroMsgID, err
+	}
+	var props *C.dpiMsgProps
+	if C.dpiConn_newMsgProps(c.dpiConn, &props) == C.DPI_FAILURE {
+		return zeroMsgID, fmt.Errorf("newMsgProps: %w", c.getError())
+	}
+	defer C.dpiMsgProps_release(props)
+	if err := message.toOra(c.drv, props); err != nil {
+		return zeroMsgID, err
+	}
+
+	cName := C.CString(queueName)
+	defer C.free(unsafe.Pointer(cName))
+	var value *C.char
+	var length C.uint
+	if C.dpiConn_enqObject(c.dpiConn, cName, C.uint(len(queueName)), opts, props,
+		message.Object.dpiObject, &value, &length,
+	) == C.DPI_FAILURE {
+		return zeroMsgID, fmt.Errorf("enqObject %q: %w", queueName, c.getError())
+	}
+	message.writeMsgID(value, length)
+	return message.MsgID, nil
+}
+
+// DeqObject dequeues a message from the named queue,
+// without creating a Queue, using dpiConn_deqObject.
+//
+// The payload is read into message.Object, which must be an Object of the queue's payload type.
+//
+// Returns the message ID, which is zero if no message is available.
+func (c *conn) DeqObject(ctx context.Context, queueName string, options DeqOptions, message *Message) ([MsgIDLength]byte, error) {
+	if message == nil || message.Object == nil {
+		return zeroMsgID, errors.New("DeqObject needs a message with an Object to read the payload into")
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cleanup, err := c.handleDeadline(ctx)
+	if err != nil {
+		return zeroMsgID, err
+	}
+	defer cleanup()
+
+	var opts *C.dpiDeqOptions
+	if C.dpiConn_newDeqOptions(c.dpiConn, &opts) == C.DPI_FAILURE {
+		return zeroMsgID, fmt.Errorf("newDeqOptions: %w", c.getError())
+	}
+	defer C.dpiDeqOptions_release(opts)
+	if err := options.toOra(c.drv, opts); err != nil {
+		return zeroMsgID, err
+	}
+	var props *C.dpiMsgProps
+	if C.dpiConn_newMsgProps(c.dpiConn, &props) == C.DPI_FAILURE {
+		return zeroMsgID, fmt.Errorf("newMsgProps: %w", c.getError())
+	}
+	defer C.dpiMsgProps_release(props)
+
+	cName := C.CString(queueName)
+	defer C.free(unsafe.Pointer(cName))
+	obj := message.Object
+	var value *C.char
+	var length C.uint
+	if C.dpiConn_deqObject(c.dpiConn, cName, C.uint(len(queueName)), opts, props,
+		obj.dpiObject, &value, &length,
+	) == C.DPI_FAILURE {
+		return zeroMsgID, fmt.Errorf("deqObject %q: %w", queueName, c.getError())
+	}
+	if value == nil {
+		*message = Message{Object: obj}
+		return zeroMsgID, nil
+	}
+	// the payload is in obj, not in props
+	err = message.fromOra(c, props, obj.ObjectType)
+	message.Object, message.Raw = obj, nil
+	message.writeMsgID(value, length)
+	return message.MsgID, err
+}
+
 // Message is a message - either received or being sent.
 type Message struct {
 	Enqueued                time.Time
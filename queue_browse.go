@@ -0,0 +1,142 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"iter"
+)
+
+// BrowseFilter restricts the messages returned by Queue.Browse.
+type BrowseFilter struct {
+	// Correlation to match (may contain % and _ wildcards).
+	Correlation string
+	// Condition is a boolean expression similar to the WHERE clause of an SQL query,
+	// on the message properties (priority, corrid ...) and the payload (tab.user_data).
+	Condition string
+	// Consumer name for multi-consumer queues.
+	Consumer string
+	// BatchSize is the number of messages fetched at once, defaults to 16.
+	BatchSize int
+}
+
+// QueueBrowser iterates over the messages of a Queue, without removing or locking them.
+//
+// It uses DeqBrowse mode, starting with NavFirst, then continuing with NavNext.
+//
+// The Queue must not be used for other operations till the QueueBrowser is closed,
+// as it changes the Queue's DeqOptions (restored by Close).
+type QueueBrowser struct {
+	ctx     context.Context
+	Q       *Queue
+	err     error
+	orig    DeqOptions
+	opts    DeqOptions
+	buf     []Message
+	msgs    []Message
+	current Message
+	started bool
+	done    bool
+}
+
+// Browse returns a QueueBrowser over the messages matching the filter.
+//
+// Call Close on the returned QueueBrowser to restore the original DeqOptions.
+func (Q *Queue) Browse(ctx context.Context, filter BrowseFilter) (*QueueBrowser, error) {
+	orig, err := Q.DeqOptions()
+	if err != nil {
+		return nil, err
+	}
+	opts := orig
+	opts.Mode = DeqBrowse
+	opts.Navigation = NavFirst
+	opts.Wait = 0
+	opts.MsgID = nil
+	opts.Correlation, opts.Condition, opts.Consumer = filter.Correlation, filter.Condition, filter.Consumer
+	if filter.BatchSize <= 0 {
+		filter.BatchSize = 16
+	}
+	if err = Q.SetDeqOptions(opts); err != nil {
+		_ = Q.SetDeqOptions(orig)
+		return nil, err
+	}
+	return &QueueBrowser{ctx: ctx, Q: Q, orig: orig, opts: opts, buf: make([]Message, filter.BatchSize)}, nil
+}
+
+// Next advances to the next message, returning false at the end or on error.
+func (B *QueueBrowser) Next() bool {
+	if B.done || B.err != nil {
+		return false
+	}
+	if len(B.msgs) == 0 {
+		if B.err = B.ctx.Err(); B.err != nil {
+			return false
+		}
+		if B.started && B.opts.Navigation != NavNext {
+			B.opts.Navigation = NavNext
+			if B.err = B.Q.SetDeqOptions(B.opts); B.err != nil {
+				return false
+			}
+		}
+		n, err := B.Q.Dequeue(B.buf)
+		B.started = true
+		if err != nil {
+			B.err = err
+			return false
+		}
+		if n == 0 {
+			B.done = true
+			return false
+		}
+		B.msgs = B.buf[:n]
+	}
+	B.current, B.msgs = B.msgs[0], B.msgs[1:]
+	return true
+}
+
+// Message returns the current message.
+//
+// The caller is responsible for closing the message's Object.
+func (B *QueueBrowser) Message() Message { return B.current }
+
+// Payload returns the current message's payload: the Object as a map (see Object.AsMap), or the Raw bytes.
+func (B *QueueBrowser) Payload(recursive bool) (interface{}, error) {
+	if B.current.Object == nil {
+		return B.current.Raw, nil
+	}
+	return B.current.Object.AsMap(recursive)
+}
+
+// Err returns the error that stopped the iteration.
+func (B *QueueBrowser) Err() error { return B.err }
+
+// Close the browser, restoring the Queue's original DeqOptions.
+func (B *QueueBrowser) Close() error {
+	if B == nil || B.Q == nil {
+		return nil
+	}
+	Q := B.Q
+	B.Q, B.done, B.msgs = nil, true, nil
+	if Q.conn == nil {
+		return nil
+	}
+	return Q.SetDeqOptions(B.orig)
+}
+
+// All returns an iterator over the messages, closing the QueueBrowser at the end.
+func (B *QueueBrowser) All() iter.Seq2[Message, error] {
+	return func(yield func(Message, error) bool) {
+		defer B.Close()
+		for B.Next() {
+			if !yield(B.Message(), nil) {
+				return
+			}
+		}
+		if err := B.Err(); err != nil {
+			yield(Message{}, err)
+		}
+	}
+}
@@ -508,3 +508,185 @@ func testQueue(
 	}
 
 }
+
+func TestConnEnqDeqObject(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("ConnEnqDeqObject"), 30*time.Second)
+	defer cancel()
+	const qName = "TEST_CONNQ"
+	const qTblName = qName + "_TBL"
+	const qTypName = qName + "_TYP"
+
+	cx, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cx.Close()
+	tearDown := func() {
+		cx.ExecContext(testContext("ConnEnqDeqObject-teardown"), `DECLARE
+	q CONSTANT VARCHAR2(61) := USER||'.'||:1;
+BEGIN
+	BEGIN SYS.DBMS_AQADM.stop_queue(q); EXCEPTION WHEN OTHERS THEN NULL; END;
+	BEGIN SYS.DBMS_AQADM.drop_queue(q); EXCEPTION WHEN OTHERS THEN NULL; END;
+	BEGIN SYS.DBMS_AQADM.drop_queue_table(USER||'.'||:2, TRUE); EXCEPTION WHEN OTHERS THEN NULL; END;
+END;`, qName, qTblName)
+		cx.ExecContext(testContext("ConnEnqDeqObject-teardown"), "DROP TYPE "+qTypName+" FORCE")
+	}
+	tearDown()
+	defer tearDown()
+	for _, qry := range []string{
+		"CREATE OR REPLACE TYPE " + qTypName + " IS OBJECT (f_vc20 VARCHAR2(20), f_num NUMBER)",
+		`BEGIN
+	SYS.DBMS_AQADM.CREATE_QUEUE_TABLE(USER||'.` + qTblName + `', USER||'.` + qTypName + `');
+	SYS.DBMS_AQADM.CREATE_QUEUE(USER||'.` + qName + `', USER||'.` + qTblName + `');
+	SYS.DBMS_AQADM.start_queue(USER||'.` + qName + `');
+END;`,
+	} {
+		if _, err := cx.ExecContext(ctx, qry); err != nil {
+			if strings.Contains(err.Error(), "PLS-00201: identifier 'SYS.DBMS_AQADM' must be declared") {
+				t.Skip(err.Error())
+			}
+			t.Fatalf("%s: %+v", qry, err)
+		}
+	}
+
+	if err = godror.Raw(ctx, cx, func(c godror.Conn) error {
+		ot, err := c.GetObjectType(qTypName)
+		if err != nil {
+			return err
+		}
+		defer ot.Close()
+		obj, err := ot.NewObject()
+		if err != nil {
+			return err
+		}
+		defer obj.Close()
+		if err = obj.Set("F_VC20", "árvíztűrő"); err != nil {
+			return err
+		}
+		if err = obj.Set("F_NUM", int64(42)); err != nil {
+			return err
+		}
+		msg := godror.Message{Object: obj, Correlation: "conn"}
+		enqID, err := c.EnqObject(ctx, qName, godror.EnqOptions{Visibility: godror.VisibleImmediate}, &msg)
+		if err != nil {
+			return err
+		}
+		t.Logf("enqueued %x", enqID)
+
+		dst, err := ot.NewObject()
+		if err != nil {
+			return err
+		}
+		defer dst.Close()
+		got := godror.Message{Object: dst}
+		deqID, err := c.DeqObject(ctx, qName, godror.DeqOptions{
+			Mode: godror.DeqRemove, Navigation: godror.NavFirst,
+			Visibility: godror.VisibleImmediate, Wait: time.Second,
+		}, &got)
+		if err != nil {
+			return err
+		}
+		if deqID != enqID {
+			return fmt.Errorf("dequeued %x, wanted %x", deqID, enqID)
+		}
+		var data godror.Data
+		if err = got.Object.GetAttribute(&data, "F_NUM"); err != nil {
+			return err
+		}
+		if n := data.GetFloat64(); n != 42 || got.Correlation != "conn" {
+			return fmt.Errorf("got %v (%q), wanted 42 (\"conn\")", n, got.Correlation)
+		}
+		return nil
+	}); err != nil {
+		var ec interface{ Code() int }
+		if errors.As(err, &ec) && ec.Code() == 24444 {
+			t.Skip(err)
+		}
+		t.Fatal(err)
+	}
+}
+
+func TestQueueBrowse(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("QueueBrowse"), 30*time.Second)
+	defer cancel()
+	const qName = "TEST_BROWSE_Q"
+	const qTblName = qName + "_TBL"
+
+	cx, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cx.Close()
+	tearDown := func() {
+		cx.ExecContext(testContext("QueueBrowse-teardown"), `DECLARE
+	q CONSTANT VARCHAR2(61) := USER||'.'||:1;
+BEGIN
+	BEGIN SYS.DBMS_AQADM.stop_queue(q); EXCEPTION WHEN OTHERS THEN NULL; END;
+	BEGIN SYS.DBMS_AQADM.drop_queue(q); EXCEPTION WHEN OTHERS THEN NULL; END;
+	BEGIN SYS.DBMS_AQADM.drop_queue_table(USER||'.'||:2, TRUE); EXCEPTION WHEN OTHERS THEN NULL; END;
+END;`, qName, qTblName)
+	}
+	tearDown()
+	defer tearDown()
+	if _, err = cx.ExecContext(ctx, `BEGIN
+	SYS.DBMS_AQADM.CREATE_QUEUE_TABLE(USER||'.`+qTblName+`', 'RAW');
+	SYS.DBMS_AQADM.CREATE_QUEUE(USER||'.`+qName+`', USER||'.`+qTblName+`');
+	SYS.DBMS_AQADM.start_queue(USER||'.`+qName+`');
+END;`); err != nil {
+		if strings.Contains(err.Error(), "PLS-00201: identifier 'SYS.DBMS_AQADM' must be declared") {
+			t.Skip(err.Error())
+		}
+		t.Fatal(err)
+	}
+
+	q, err := godror.NewQueue(ctx, cx, qName, "", godror.WithEnqOptions(godror.EnqOptions{
+		Visibility: godror.VisibleImmediate, DeliveryMode: godror.DeliverPersistent,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+	msgs := []godror.Message{
+		{Raw: []byte("a"), Correlation: "x"},
+		{Raw: []byte("b"), Correlation: "y"},
+		{Raw: []byte("c"), Correlation: "x"},
+	}
+	if err = q.Enqueue(msgs); err != nil {
+		var ec interface{ Code() int }
+		if errors.As(err, &ec) && ec.Code() == 24444 {
+			t.Skip(err)
+		}
+		t.Fatal(err)
+	}
+
+	for _, tC := range []struct {
+		Filter godror.BrowseFilter
+		Want   string
+	}{
+		{Filter: godror.BrowseFilter{BatchSize: 2}, Want: "abc"},
+		{Filter: godror.BrowseFilter{Correlation: "x"}, Want: "ac"},
+	} {
+		// browse twice to check that the messages are not consumed
+		for i := 0; i < 2; i++ {
+			b, err := q.Browse(ctx, tC.Filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []byte
+			for m, err := range b.All() {
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, m.Raw...)
+			}
+			if string(got) != tC.Want {
+				t.Errorf("%d. %+v: got %q, wanted %q", i, tC.Filter, got, tC.Want)
+			}
+		}
+	}
+	if opts, err := q.DeqOptions(); err != nil {
+		t.Fatal(err)
+	} else if opts.Mode == godror.DeqBrowse {
+		t.Errorf("DeqOptions not restored: %+v", opts)
+	}
+}
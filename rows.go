@@ -134,7 +134,15 @@ func (r *rows) ColumnTypeLength(index int) (length int64, ok bool) {
 // Type names should be uppercase.
 // Examples of returned types: "VARCHAR", "NVARCHAR", "VARCHAR2", "CHAR", "TEXT", "DECIMAL", "SMALLINT", "INT", "BIGINT", "BOOL", "[]BIGINT", "JSONB", "XML", "TIMESTAMP".
 func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
-	switch r.columns[index].OrigOracleType {
+	if nm := oracleTypeName(r.columns[index].OrigOracleType); nm != "" {
+		return nm
+	}
+	return fmt.Sprintf("OTHER[%d]", r.columns[index].OracleType)
+}
+
+// oracleTypeName returns the database type name of typ (without the length), or "" if it is unknown.
+func oracleTypeName(typ C.dpiOracleTypeNum) string {
+	switch typ {
 	case C.DPI_ORACLE_TYPE_VARCHAR:
 		return "VARCHAR2"
 	case C.DPI_ORACLE_TYPE_NVARCHAR:
@@ -194,7 +202,7 @@ func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
 	case C.DPI_ORACLE_TYPE_VECTOR:
 		return "VECTOR"
 	default:
-		return fmt.Sprintf("OTHER[%d]", r.columns[index].OracleType)
+		return ""
 	}
 }
 
@@ -696,10 +704,15 @@ func (r *rows) Next(dest []driver.Value) error {
 			}); err != nil {
 				return err
 			}
-			dest[i], err = GetVectorValue(&vectorInfo)
+			vec, err := GetVectorValue(&vectorInfo)
 			if err != nil {
 				return err
 			}
+			if r.VectorSlices() && !vec.IsSparse {
+				dest[i] = vec.Values
+			} else {
+				dest[i] = vec
+			}
 
 		default:
 			return fmt.Errorf("unsupported column type %d", typ)
@@ -0,0 +1,377 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ScanAll reads the remaining rows of a REF CURSOR (driver.Rows, as returned for an OUT parameter)
+// into a slice of T structs (or pointers to structs).
+//
+// The columns are mapped to the fields by the "godror" or "db" struct tag,
+// or by the field name (case-insensitively, ignoring underscores), unmapped columns are skipped.
+//
+// ScanAll does not Close rows, so with implicit results (driver.RowsNextResultSet)
+// it can be called again after NextResultSet.
+func ScanAll[T any](ctx context.Context, rows driver.Rows) ([]T, error) {
+	return collectScanned(ScanEach[T](ctx, rows))
+}
+
+// ScanEach returns an iterator over the remaining rows of a REF CURSOR (driver.Rows) scanned into T,
+// the same way as ScanAll. It stops at the first error.
+func ScanEach[T any](ctx context.Context, rows driver.Rows) iter.Seq2[T, error] {
+	return scanEach[T](ctx, driverRowSource{Rows: rows})
+}
+
+// ScanAllSQL reads the remaining rows of a query (or of the current implicit result set)
+// into a slice of T structs (or pointers to structs), the same way as ScanAll.
+//
+// ScanAllSQL does not Close rows, so it can be called again after rows.NextResultSet.
+func ScanAllSQL[T any](rows *sql.Rows) ([]T, error) {
+	return collectScanned(ScanEachSQL[T](rows))
+}
+
+// ScanEachSQL returns an iterator over the remaining rows of a query scanned into T,
+// the same way as ScanAll. It stops at the first error.
+func ScanEachSQL[T any](rows *sql.Rows) iter.Seq2[T, error] {
+	return scanEach[T](context.Background(), &sqlRowSource{Rows: rows})
+}
+
+func collectScanned[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+	for v, err := range seq {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, v)
+	}
+	return all, nil
+}
+
+// rowSource is the common interface of driver.Rows and *sql.Rows for scanning into structs.
+type rowSource interface {
+	columns() ([]string, error)
+	// scanType returns the ColumnTypeScanType of the i-th column, or nil if unknown.
+	scanType(i int) reflect.Type
+	// next reads the next row into dest, returns io.EOF at the end.
+	next(dest []driver.Value) error
+}
+
+type driverRowSource struct{ driver.Rows }
+
+func (rs driverRowSource) columns() ([]string, error) { return rs.Rows.Columns(), nil }
+func (rs driverRowSource) scanType(i int) reflect.Type {
+	if st, ok := rs.Rows.(driver.RowsColumnTypeScanType); ok {
+		return st.ColumnTypeScanType(i)
+	}
+	return nil
+}
+func (rs driverRowSource) next(dest []driver.Value) error { return rs.Rows.Next(dest) }
+
+type sqlRowSource struct {
+	*sql.Rows
+	types []*sql.ColumnType
+	vals  []interface{}
+	ptrs  []interface{}
+}
+
+func (rs *sqlRowSource) columns() ([]string, error) {
+	var err error
+	if rs.types, err = rs.Rows.ColumnTypes(); err != nil {
+		return nil, err
+	}
+	names := make([]string, len(rs.types))
+	for i, ct := range rs.types {
+		names[i] = ct.Name()
+	}
+	rs.vals = make([]interface{}, len(names))
+	rs.ptrs = make([]interface{}, len(names))
+	for i := range rs.vals {
+		rs.ptrs[i] = &rs.vals[i]
+	}
+	return names, nil
+}
+func (rs *sqlRowSource) scanType(i int) reflect.Type {
+	if i < len(rs.types) {
+		return rs.types[i].ScanType()
+	}
+	return nil
+}
+func (rs *sqlRowSource) next(dest []driver.Value) error {
+	if !rs.Rows.Next() {
+		if err := rs.Rows.Err(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	if err := rs.Rows.Scan(rs.ptrs...); err != nil {
+		return err
+	}
+	for i, v := range rs.vals {
+		dest[i] = v
+	}
+	return nil
+}
+
+func scanEach[T any](ctx context.Context, rs rowSource) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		rt := reflect.TypeOf(zero)
+		isPtr := rt != nil && rt.Kind() == reflect.Ptr
+		if isPtr {
+			rt = rt.Elem()
+		}
+		if rt == nil || rt.Kind() != reflect.Struct {
+			yield(zero, fmt.Errorf("scan into %T: not a struct: %w", zero, errUnknownType))
+			return
+		}
+		cols, err := rs.columns()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		plan := newScanPlan(rt, cols, rs.scanType)
+		dest := make([]driver.Value, len(cols))
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			if err := rs.next(dest); err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield(zero, err)
+				}
+				return
+			}
+			rv := reflect.New(rt)
+			if err := plan.assign(rv.Elem(), dest); err != nil {
+				yield(zero, err)
+				return
+			}
+			var v T
+			if isPtr {
+				v = rv.Interface().(T)
+			} else {
+				v = rv.Elem().Interface().(T)
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// scanPlan is the mapping of the columns to the struct fields, computed once per result set.
+type scanPlan struct {
+	cols   []string
+	fields [][]int // field index for each column, nil if not mapped
+	direct []bool  // the column's scan type is assignable to the field
+}
+
+func newScanPlan(rt reflect.Type, cols []string, scanType func(int) reflect.Type) scanPlan {
+	byName := structFieldsByKey(rt)
+	plan := scanPlan{cols: cols, fields: make([][]int, len(cols)), direct: make([]bool, len(cols))}
+	for i, c := range cols {
+		idx := byName[scanColumnKey(c)]
+		if idx == nil {
+			continue
+		}
+		plan.fields[i] = idx
+		if st := scanType(i); st != nil {
+			plan.direct[i] = st.AssignableTo(rt.FieldByIndex(idx).Type)
+		}
+	}
+	return plan
+}
+
+// structFieldsByKey returns the indices of the exported fields of the struct type rt,
+// by the scanColumnKey of their "godror" or "db" tag or their name.
+func structFieldsByKey(rt reflect.Type) map[string][]int {
+	byName := make(map[string][]int, rt.NumField())
+	for _, f := range reflect.VisibleFields(rt) {
+		if !f.IsExported() || f.Anonymous && f.Type.Kind() == reflect.Struct {
+			continue
+		}
+		name, _, _ := parseStructTag(f.Tag)
+		if name == "" {
+			name, _, _ = strings.Cut(f.Tag.Get("db"), ",")
+		}
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		key := scanColumnKey(name)
+		if _, ok := byName[key]; !ok {
+			byName[key] = f.Index
+		}
+	}
+	return byName
+}
+
+// scanColumnKey normalizes the column and field names: uppercase, without underscores.
+func scanColumnKey(s string) string { return strings.ToUpper(strings.ReplaceAll(s, "_", "")) }
+
+func (plan scanPlan) assign(rv reflect.Value, values []driver.Value) error {
+	for i, idx := range plan.fields {
+		if idx == nil {
+			continue
+		}
+		f := rv.FieldByIndex(idx)
+		v := values[i]
+		if plan.direct[i] && v != nil {
+			if vv := reflect.ValueOf(v); vv.Type().AssignableTo(f.Type()) {
+				if b, ok := v.([]byte); ok {
+					vv = reflect.ValueOf(bytes.Clone(b))
+				}
+				f.Set(vv)
+				continue
+			}
+		}
+		if err := assignScanValue(f, v); err != nil {
+			return fmt.Errorf("%s: %w", plan.cols[i], err)
+		}
+	}
+	return nil
+}
+
+// assignScanValue sets dst from the driver value src, converting as database/sql would.
+func assignScanValue(dst reflect.Value, src driver.Value) error {
+	if dst.CanAddr() {
+		if sc, ok := dst.Addr().Interface().(sql.Scanner); ok {
+			return sc.Scan(src)
+		}
+	}
+	if src == nil {
+		dst.SetZero()
+		return nil
+	}
+	if dst.Kind() == reflect.Ptr {
+		p := reflect.New(dst.Type().Elem())
+		if err := assignScanValue(p.Elem(), src); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	}
+	switch x := src.(type) {
+	case *Lob:
+		b, err := io.ReadAll(x)
+		if err != nil {
+			return err
+		}
+		src = b
+	case Number:
+		src = string(x)
+	case NullTime:
+		if !x.Valid {
+			dst.SetZero()
+			return nil
+		}
+		src = x.Time
+	}
+	sv := reflect.ValueOf(src)
+	if b, ok := src.([]byte); ok {
+		if dst.Kind() == reflect.String {
+			dst.SetString(string(b))
+			return nil
+		}
+		sv = reflect.ValueOf(bytes.Clone(b))
+	}
+	if sv.Type().AssignableTo(dst.Type()) {
+		dst.Set(sv)
+		return nil
+	}
+	if s, ok := src.(string); ok {
+		return setScanString(dst, s)
+	}
+	switch dst.Kind() {
+	case reflect.String:
+		switch src.(type) {
+		case int64, uint64, float64, float32, bool:
+			dst.SetString(fmt.Sprint(src))
+			return nil
+		}
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		// Go through the string form (as database/sql's convertAssign does),
+		// to return an error instead of silently truncating or overflowing.
+		if s, ok := scanNumberString(sv); ok {
+			if err := setScanString(dst, s); err != nil {
+				return fmt.Errorf("scan %T %s into %s: %w", src, s, dst.Type(), err)
+			}
+			return nil
+		}
+	default:
+		if sv.CanConvert(dst.Type()) {
+			dst.Set(sv.Convert(dst.Type()))
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot scan %T into %s", src, dst.Type())
+}
+
+// scanNumberString returns the string form of the numeric or bool value.
+func scanNumberString(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	}
+	return "", false
+}
+
+func setScanString(dst reflect.Value, s string) error {
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		dst.SetBool(b)
+	default:
+		return fmt.Errorf("cannot scan string into %s", dst.Type())
+	}
+	return nil
+}
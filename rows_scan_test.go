@@ -0,0 +1,148 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeRows struct {
+	cols  []string
+	types []reflect.Type
+	rows  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.cols }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+func (r *fakeRows) ColumnTypeScanType(i int) reflect.Type { return r.types[i] }
+
+func TestScanAll(t *testing.T) {
+	type base struct {
+		ID int64 `godror:"EMP_ID"`
+	}
+	type emp struct {
+		HireDate time.Time
+		Comm     *float64
+		Note     sql.NullString
+		Name     string `db:"ENAME"`
+		Ignored  string `godror:"-"`
+		base
+		Salary float64
+		DeptNo int32
+	}
+	now := time.Now()
+	newRows := func() *fakeRows {
+		return &fakeRows{
+			cols: []string{"EMP_ID", "ENAME", "SALARY", "DEPT_NO", "HIRE_DATE", "COMM", "NOTE", "IGNORED", "EXTRA"},
+			types: []reflect.Type{
+				reflect.TypeOf(int64(0)), reflect.TypeOf(""), reflect.TypeOf(Number("")), reflect.TypeOf(Number("")),
+				reflect.TypeOf(NullTime{}), reflect.TypeOf(Number("")), reflect.TypeOf(""), reflect.TypeOf(""), reflect.TypeOf(""),
+			},
+			rows: [][]driver.Value{
+				{int64(1), "King", Number("5000.5"), Number("10"), now, nil, nil, "x", "y"},
+				{int64(2), []byte("Blake"), Number("2850"), int64(30), now.Add(time.Hour), Number("1.5"), "note", "x", "y"},
+			},
+		}
+	}
+	comm := 1.5
+	want := []emp{
+		{base: base{ID: 1}, Name: "King", Salary: 5000.5, DeptNo: 10, HireDate: now},
+		{base: base{ID: 2}, Name: "Blake", Salary: 2850, DeptNo: 30, HireDate: now.Add(time.Hour), Comm: &comm, Note: sql.NullString{String: "note", Valid: true}},
+	}
+
+	got, err := ScanAll[emp](context.Background(), newRows())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(want, got, cmp.AllowUnexported(emp{})); d != "" {
+		t.Error(d)
+	}
+
+	var n int
+	for e, err := range ScanEach[*emp](context.Background(), newRows()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := cmp.Diff(&want[n], e, cmp.AllowUnexported(emp{})); d != "" {
+			t.Errorf("%d. %s", n, d)
+		}
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("iterated %d rows, wanted 1", n)
+	}
+
+	rows := newRows()
+	rows.rows[1][2] = "not a number"
+	if _, err = ScanAll[emp](context.Background(), rows); err == nil {
+		t.Error("wanted error for non-numeric SALARY")
+	}
+	if _, err = ScanAll[int](context.Background(), newRows()); err == nil {
+		t.Error("wanted error for non-struct")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = ScanAll[emp](ctx, newRows()); err == nil {
+		t.Error("wanted error for canceled context")
+	}
+}
+
+func TestAssignScanValue(t *testing.T) {
+	t.Parallel()
+	var i64 int64
+	var i8 int8
+	var u8 uint8
+	var f32 float32
+	var b bool
+	for _, tC := range []struct {
+		dst     any
+		src     driver.Value
+		want    any
+		wantErr bool
+	}{
+		{dst: &i64, src: 1.9, wantErr: true},
+		{dst: &i64, src: 2.0, want: int64(2)},
+		{dst: &i64, src: Number("3"), want: int64(3)},
+		{dst: &i8, src: int64(300), wantErr: true},
+		{dst: &i8, src: int64(-128), want: int8(-128)},
+		{dst: &u8, src: int64(-1), wantErr: true},
+		{dst: &f32, src: 1e300, wantErr: true},
+		{dst: &f32, src: 0.5, want: float32(0.5)},
+		{dst: &b, src: int64(1), want: true},
+		{dst: &i64, src: time.Time{}, wantErr: true},
+	} {
+		dst := reflect.ValueOf(tC.dst).Elem()
+		err := assignScanValue(dst, tC.src)
+		if tC.wantErr {
+			if err == nil {
+				t.Errorf("%T %v into %s: wanted error, got %v", tC.src, tC.src, dst.Type(), dst.Interface())
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%T %v into %s: %+v", tC.src, tC.src, dst.Type(), err)
+		} else if got := dst.Interface(); got != tC.want {
+			t.Errorf("%T %v into %s: got %v, wanted %v", tC.src, tC.src, dst.Type(), got, tC.want)
+		}
+	}
+}
@@ -70,6 +70,9 @@ type stmtOptions struct {
 	partialBatch       bool
 	warningAsError     bool
 	noRetry            bool
+	vectorSlices       bool
+	noDbmsOutput       bool
+	checkCompileErrors bool
 }
 
 type boolString struct {
@@ -142,6 +145,7 @@ func (o stmtOptions) DeleteFromCache() bool { return o.deleteFromCache }
 func (o stmtOptions) NumberAsString() bool  { return o.numberAsString }
 func (o stmtOptions) NumberAsFloat64() bool { return o.numberAsFloat64 }
 func (o stmtOptions) PartialBatch() bool    { return o.partialBatch }
+func (o stmtOptions) VectorSlices() bool    { return o.vectorSlices }
 
 // Option holds statement options.
 //
@@ -299,9 +303,30 @@ func PartialBatch() Option { return func(o *stmtOptions) { o.partialBatch = true
 // Return ORA-24344 warning as an error
 func WarningAsError() Option { return func(o *stmtOptions) { o.warningAsError = true } }
 
+// CheckCompileErrors is an option to return the CompileDiagnostics of the object
+// created by a CREATE OR REPLACE (or compiled by ALTER ... COMPILE) statement as an error,
+// instead of succeeding with the ORA-24344 "success with compilation error" warning.
+//
+//	_, err := db.ExecContext(ctx, "CREATE OR REPLACE PROCEDURE ...", godror.CheckCompileErrors())
+//	var cd godror.CompileDiagnostics
+//	if errors.As(err, &cd) {
+//		fmt.Println(cd.Error())
+//	}
+func CheckCompileErrors() Option { return func(o *stmtOptions) { o.checkCompileErrors = true } }
+
 // Do not re-execute statement if ORA-04061, ORA-04065 or ORA-04068 occurs
 func NoRetry() Option { return func(o *stmtOptions) { o.noRetry = true } }
 
+// VectorSlices is an option to bind []float32, []float64 and []int8 as one dense VECTOR
+// (not as an array), and to return dense VECTOR columns as such slices (not as Vector).
+//
+// The scan destination must match the column's vector format
+// (FLOAT32: []float32, FLOAT64: []float64, INT8: []int8, BINARY: []uint8);
+// sparse vectors are still returned as Vector.
+//
+// Use it "naked", without sql.Named!
+func VectorSlices() Option { return func(o *stmtOptions) { o.vectorSlices = true } }
+
 const minChunkSize = 1 << 16
 
 var _ driver.Stmt = (*statement)(nil)
@@ -419,7 +444,7 @@ func newDoneCh() (<-chan struct{}, func()) {
 // ExecContext must honor the context timeout and return when it is canceled.
 //
 // Cancelation/timeout is honored, execution is broken, but you may have to disable out-of-bound execution - see https://github.com/oracle/odpi/issues/116 for details.
-func (st *statement) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+func (st *statement) ExecContext(ctx context.Context, args []driver.NamedValue) (res driver.Result, err error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -439,6 +464,34 @@ func (st *statement) ExecContext(ctx context.Context, args []driver.NamedValue)
 		*(args[0].Value.(sql.Out).Dest.(*interface{})) = st.conn
 		return driver.ResultNoRows, nil
 	}
+	if hasStringMap(args) {
+		return st.execStringMaps(ctx, args)
+	}
+
+	// The deferred functions below run after the conn.mu.RUnlock,
+	// with the original ctx (not the callTimeout one).
+	origCtx := ctx
+	if !st.noDbmsOutput && st.conn.wantDbmsOutput(ctx) {
+		if err := st.conn.enableDbmsOutput(ctx); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if origCtx.Err() != nil {
+				return
+			}
+			if err := st.conn.drainDbmsOutput(origCtx, logger); err != nil && logger != nil {
+				logger.Warn("drain DBMS_OUTPUT", "error", err)
+			}
+		}()
+	}
+	var compileWarning error // ORA-24344, for CheckCompileErrors
+	if st.checkCompileErrors {
+		defer func() {
+			if compileWarning != nil && (err == nil || err == compileWarning) && origCtx.Err() == nil {
+				res, err = nil, st.conn.compileError(origCtx, st.query, compileWarning)
+			}
+		}()
+	}
 
 	st.conn.mu.RLock()
 	defer st.conn.mu.RUnlock()
@@ -472,9 +525,22 @@ func (st *statement) ExecContext(ctx context.Context, args []driver.NamedValue)
 
 	mode := st.ExecMode()
 	//fmt.Printf("%p.%p: inTran? %t\n%s\n", st.conn, st, st.inTransaction, st.query)
-	if !st.inTransaction {
+	// LobStream writes the LOB after the execution, so it needs the row lock till then.
+	commitAfterGet := !st.inTransaction && hasLobStream(st.dests)
+	if !st.inTransaction && !commitAfterGet {
 		mode |= C.DPI_MODE_EXEC_COMMIT_ON_SUCCESS
 	}
+	if commitAfterGet {
+		defer func() {
+			if err == nil {
+				if err = st.checkExec(func() C.int { return C.dpiConn_commit(st.dpiConn) }); err != nil {
+					res, err = nil, fmt.Errorf("commit: %w", err)
+				}
+				return
+			}
+			_ = st.checkExec(func() C.int { return C.dpiConn_rollback(st.dpiConn) })
+		}()
+	}
 	if st.DeleteFromCache() {
 		C.dpiStmt_deleteFromCache(st.dpiStmt)
 	}
@@ -513,7 +579,7 @@ func (st *statement) ExecContext(ctx context.Context, args []driver.NamedValue)
 		}
 		if err = func() error {
 			defer close(done)
-			if st.warningAsError {
+			if st.warningAsError || st.checkCompileErrors {
 				return st.checkExecWithWarning(f)
 			} else {
 				return st.checkExec(f)
@@ -528,6 +594,17 @@ func (st *statement) ExecContext(ctx context.Context, args []driver.NamedValue)
 			break
 		}
 	}
+	if st.checkCompileErrors && !st.warningAsError && err != nil {
+		var oe *OraErr
+		if errors.As(err, &oe) && oe.IsWarning() {
+			if isCompileWarning(err) {
+				compileWarning = err
+			}
+			err = nil
+		}
+	} else if st.checkCompileErrors && isCompileWarning(err) {
+		compileWarning = err
+	}
 	if err != nil && (!many || !st.PartialBatch() || closeIfBadConn(err) == driver.ErrBadConn) {
 		return nil, err
 	}
@@ -926,7 +1003,11 @@ func (st *statement) bindVars(ctx context.Context, args []driver.NamedValue, log
 			// deref in rArgs, but NOT value!
 			rArgs[i] = rv.Elem()
 		}
-		if _, isByteSlice := value.([]byte); !isByteSlice {
+		_, isByteSlice := value.([]byte)
+		if !isByteSlice && st.VectorSlices() {
+			_, isByteSlice = vectorFromSlice(value)
+		}
+		if !isByteSlice {
 			st.isSlice[i] = rArgs[i].Kind() == reflect.Slice
 			if !st.PlSQLArrays() && st.isSlice[i] {
 				n := rArgs[i].Len()
@@ -1103,7 +1184,28 @@ func (st *statement) bindVarTypeSwitch(ctx context.Context, info *argInfo, get *
 		}
 	}
 
+	if st.VectorSlices() {
+		if vec, ok := vectorFromSlice(value); ok {
+			value = vec
+		}
+	}
+
 	switch v := value.(type) {
+	case BFile, []BFile:
+		info.typ, info.natTyp = C.DPI_ORACLE_TYPE_BFILE, C.DPI_NATIVE_TYPE_LOB
+		info.set = st.dataSetBFile
+		if info.isOut {
+			*get = st.dataGetBFile
+		}
+	case LobStream:
+		info.typ, info.natTyp = C.DPI_ORACLE_TYPE_BLOB, C.DPI_NATIVE_TYPE_LOB
+		if v.IsClob {
+			info.typ = C.DPI_ORACLE_TYPE_CLOB
+		}
+		info.set = dataSetNull
+		if info.isOut {
+			*get = st.dataGetLobStream
+		}
 	case Lob, []Lob:
 		info.typ, info.natTyp = C.DPI_ORACLE_TYPE_BLOB, C.DPI_NATIVE_TYPE_LOB
 		var isClob bool
@@ -1347,6 +1449,29 @@ func (st *statement) bindVarTypeSwitch(ctx context.Context, info *argInfo, get *
 			*get = st.dataGetObject
 		}
 
+	case stringMap:
+		return value, fmt.Errorf("bindVarTypeSwitch(%T): StringMap can only be bound by Exec: %w", value, ErrNotSupported)
+
+	case genericObject:
+		ot, err := v.objectType(ctx, st.conn)
+		if err != nil {
+			return value, fmt.Errorf("%T: %w", value, err)
+		}
+		info.objType = ot.dpiObjectType
+		info.typ, info.natTyp = C.DPI_ORACLE_TYPE_OBJECT, C.DPI_NATIVE_TYPE_OBJECT
+		info.set = func(ctx context.Context, dv *C.dpiVar, data []C.dpiData, vv interface{}) error {
+			return st.dataSetObjectStruct(ctx, ot, dv, &data[0], vv.(genericObject).objectValue())
+		}
+		if info.isOut {
+			*get = func(ctx context.Context, v interface{}, data []C.dpiData) error {
+				dest, ok := v.(genericObjectDest)
+				if !ok {
+					return fmt.Errorf("%T is not a pointer to Collection or ObjectOf", v)
+				}
+				return st.dataGetObjectStruct(ctx, ot, dest.objectDest(), data)
+			}
+		}
+
 	case userType:
 		info.objType = v.ObjectRef().ObjectType.dpiObjectType
 		info.typ, info.natTyp = C.DPI_ORACLE_TYPE_OBJECT, C.DPI_NATIVE_TYPE_OBJECT
@@ -1427,6 +1552,10 @@ func (st *statement) bindVarTypeSwitch(ctx context.Context, info *argInfo, get *
 				return value, nil
 			}
 
+			if rt.Kind() == reflect.Map && rt.Key().Kind() == reflect.String {
+				// OCI can only bind associative arrays indexed by PLS_INTEGER.
+				return value, fmt.Errorf("bindVarTypeSwitch(%T): bind INDEX BY VARCHAR2 tables as StringMap: %w", value, ErrNotSupported)
+			}
 			if ot, err := st.conn.getStructObjectType(ctx, value, ""); err != nil {
 				if logger != nil {
 					logger.Error("getStructObjectType", "value", fmt.Sprintf("%T", value), "error", err)
@@ -3145,7 +3274,9 @@ func (c *conn) dataGetObjectStructObj(ctx context.Context, rv reflect.Value, obj
 					ev = ev.Convert(ret)
 				}
 				re.Set(ev)
-				if c, ok := x.(io.Closer); ok {
+				if _, ok := x.(*Lob); ok {
+					// handed over to the destination
+				} else if c, ok := x.(io.Closer); ok {
 					c.Close()
 				}
 			}
@@ -3284,9 +3415,17 @@ Loop:
 					}
 				} else if kind == reflect.Slice &&
 					(vv.Elem().Kind() == reflect.Struct || (vv.Elem().Kind() == reflect.Ptr && vv.Elem().Elem().Kind() == reflect.Struct)) {
-					ot, err := c.getStructObjectType(ctx, v, fieldTag)
-					if err != nil {
-						return err
+					// The attribute knows its type, the conn is needed only as a fallback,
+					// and it is nil when called from Scan (scanGenericObject).
+					ot := obj.ObjectType.Attributes[nm].ObjectType
+					if ot == nil {
+						if c == nil {
+							return fmt.Errorf("%s: unknown object type of the attribute: %w", nm, errUnknownType)
+						}
+						var err error
+						if ot, err = c.getStructObjectType(ctx, v, fieldTag); err != nil {
+							return err
+						}
 					}
 					if err := c.dataGetObjectStruct(ctx, ot, v, []C.dpiData{ad.dpiData}); err != nil {
 						return err
@@ -3468,6 +3607,9 @@ func (c *conn) dataSetJSONValue(ctx context.Context, dv *C.dpiVar, data []C.dpiD
 	}
 	switch x := vv.(type) {
 	case JSONValue:
+		if x.Value == nil {
+			return dataSetNull(ctx, dv, data, nil)
+		}
 		v := reflect.ValueOf(x.Value)
 		t := v.Type()
 		switch t.Kind() {
@@ -3475,7 +3617,7 @@ func (c *conn) dataSetJSONValue(ctx context.Context, dv *C.dpiVar, data []C.dpiD
 			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
 			reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
 			reflect.Uint32, reflect.Uint64, reflect.Float32,
-			reflect.Float64:
+			reflect.Float64, reflect.Struct, reflect.Ptr, reflect.Array:
 			data[0].isNull = 0
 			var dpijsonnode *C.dpiJsonNode
 			err = allocdpiJSONNode(x.Value, &dpijsonnode)
@@ -3491,6 +3633,10 @@ func (c *conn) dataSetJSONValue(ctx context.Context, dv *C.dpiVar, data []C.dpiD
 		}
 	case []JSONValue:
 		for i := range x {
+			if x[i].Value == nil {
+				data[i].isNull = 1
+				continue
+			}
 			data[i].isNull = 0
 
 			v := reflect.ValueOf(x[i].Value)
@@ -3500,7 +3646,7 @@ func (c *conn) dataSetJSONValue(ctx context.Context, dv *C.dpiVar, data []C.dpiD
 				reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
 				reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
 				reflect.Uint32, reflect.Uint64, reflect.Float32,
-				reflect.Float64:
+				reflect.Float64, reflect.Struct, reflect.Ptr, reflect.Array:
 				var dpijsonnode *C.dpiJsonNode
 				err = allocdpiJSONNode(x[i].Value, &dpijsonnode)
 				if err != nil {
@@ -3599,6 +3745,21 @@ func (c *conn) dataGetVectorValue(ctx context.Context, v interface{},
 			return fmt.Errorf("dataSetVectorValue %w", err)
 		}
 		*out, err = GetVectorValue(&vectorInfo)
+	case *[]float32, *[]float64, *[]int8:
+		var vec Vector
+		if len(data) != 0 && data[0].isNull == 0 {
+			if err = c.dataGetVectorValue(ctx, &vec, data); err != nil {
+				return err
+			}
+		}
+		switch out := out.(type) {
+		case *[]float32:
+			*out, err = vec.Float32s()
+		case *[]float64:
+			*out, err = vec.Float64s()
+		case *[]int8:
+			*out, err = vec.Int8s()
+		}
 	default:
 		return fmt.Errorf("dataGetVectorValue not implemented for type %T", out)
 	}
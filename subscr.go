@@ -23,6 +23,8 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -55,6 +57,112 @@ func SubscrClientInitiated(b bool) SubscriptionOption {
 	}
 }
 
+// SubscrReliable sets whether the notifications should be persisted in the database,
+// so they survive an instance failure (DPI_SUBSCR_QOS_RELIABLE).
+// The default is best effort delivery.
+func SubscrReliable(b bool) SubscriptionOption {
+	return func(p *subscriptionParams) {
+		if b {
+			p.QOS = (p.QOS | SubscrQOSReliable) &^ SubscrQOSBestEffort
+		} else {
+			p.QOS = (p.QOS | SubscrQOSBestEffort) &^ SubscrQOSReliable
+		}
+	}
+}
+
+// SubscrDeregisterOnNotification sets whether the subscription should be deregistered
+// after the first notification is received (DPI_SUBSCR_QOS_DEREG_NFY).
+func SubscrDeregisterOnNotification(b bool) SubscriptionOption {
+	return func(p *subscriptionParams) { p.QOS = p.QOS.set(SubscrQOSDeregNfy, b) }
+}
+
+// SubscrQueryLevel sets whether the notifications should be at query level (Continuous Query Notification, the default),
+// or at table level (Database Change Notification) when b is false.
+func SubscrQueryLevel(b bool) SubscriptionOption {
+	return func(p *subscriptionParams) { p.QOS = p.QOS.set(SubscrQOSQuery, b) }
+}
+
+// SubscrRowids sets whether the notifications should contain the ROWIDs of the changed rows (the default).
+func SubscrRowids(b bool) SubscriptionOption {
+	return func(p *subscriptionParams) { p.QOS = p.QOS.set(SubscrQOSRowids, b) }
+}
+
+// SubscrOperations restricts the notifications to the given operations (OpInsert, OpUpdate, OpDelete, OpAlter, OpDrop).
+//
+// The default (no operation given, or OpAll) is to notify on all operations.
+func SubscrOperations(ops ...Operation) SubscriptionOption {
+	return func(p *subscriptionParams) {
+		p.Operations = OpAll
+		for _, op := range ops {
+			p.Operations |= op
+		}
+	}
+}
+
+// SubscrTimeout sets the time after which the subscription is automatically deregistered.
+//
+// The default value of 0 means the subscription never expires.
+// The timeout is rounded up to whole seconds, so a sub-second timeout does not mean "never".
+func SubscrTimeout(d time.Duration) SubscriptionOption {
+	if d > 0 {
+		d = (d + time.Second - 1).Truncate(time.Second)
+	}
+	return func(p *subscriptionParams) { p.Timeout = d }
+}
+
+// SubscrGrouping sets the grouping (batching) of the notifications.
+//
+// With GroupingClassTime the value is in seconds, and the notifications
+// are sent once for each interval, summarizing all the events (GroupingTypeSummary)
+// or containing only the last event (GroupingTypeLast).
+func SubscrGrouping(class GroupingClass, value uint32, typ GroupingType) SubscriptionOption {
+	return func(p *subscriptionParams) {
+		p.GroupingClass, p.GroupingValue, p.GroupingType = class, value, typ
+	}
+}
+
+// SubscrQOS is the quality of service flags of a Subscription.
+type SubscrQOS C.dpiSubscrQOS
+
+// Quality of service flags for subscriptions.
+const (
+	// SubscrQOSReliable makes the notifications persistent in the database.
+	SubscrQOSReliable = SubscrQOS(C.DPI_SUBSCR_QOS_RELIABLE)
+	// SubscrQOSDeregNfy deregisters the subscription after the first notification.
+	SubscrQOSDeregNfy = SubscrQOS(C.DPI_SUBSCR_QOS_DEREG_NFY)
+	// SubscrQOSRowids requests ROWIDs of the changed rows in the notifications.
+	SubscrQOSRowids = SubscrQOS(C.DPI_SUBSCR_QOS_ROWIDS)
+	// SubscrQOSQuery requests query-level (Continuous Query Notification) granularity.
+	SubscrQOSQuery = SubscrQOS(C.DPI_SUBSCR_QOS_QUERY)
+	// SubscrQOSBestEffort allows the database to skip notifications under load.
+	SubscrQOSBestEffort = SubscrQOS(C.DPI_SUBSCR_QOS_BEST_EFFORT)
+
+	defaultSubscrQOS = SubscrQOSBestEffort | SubscrQOSQuery | SubscrQOSRowids
+)
+
+func (q SubscrQOS) set(flag SubscrQOS, b bool) SubscrQOS {
+	if b {
+		return q | flag
+	}
+	return q &^ flag
+}
+
+// GroupingClass is the class of notification grouping.
+type GroupingClass C.dpiSubscrGroupingClass
+
+// GroupingClassTime groups the notifications by time.
+const GroupingClassTime = GroupingClass(C.DPI_SUBSCR_GROUPING_CLASS_TIME)
+
+// GroupingType is the type of notification grouping.
+type GroupingType C.dpiSubscrGroupingType
+
+const (
+	// GroupingTypeSummary sends a summary of all the events in the group.
+	GroupingTypeSummary = GroupingType(C.DPI_SUBSCR_GROUPING_TYPE_SUMMARY)
+	// GroupingTypeLast sends only the last event in the group.
+	GroupingTypeLast = GroupingType(C.DPI_SUBSCR_GROUPING_TYPE_LAST)
+)
+
 // subscrParams are parameters for a new Subscription.
 type subscriptionParams struct {
 	// IPAddress on which the subscription listens to receive notifications,
@@ -75,6 +183,30 @@ type subscriptionParams struct {
 	// This feature is only available when Oracle Client 19.4
 	// and Oracle Database 19.4 or higher are being used.
 	ClientInitiated bool
+
+	// QOS is the quality of service flags, defaults to SubscrQOSBestEffort|SubscrQOSQuery|SubscrQOSRowids.
+	QOS SubscrQOS
+
+	// Operations to be notified about, defaults to OpAll.
+	Operations Operation
+
+	// Timeout after which the subscription is deregistered, 0 means never.
+	Timeout time.Duration
+
+	// GroupingClass, GroupingValue and GroupingType specify the grouping of notifications.
+	// The zero GroupingClass means no grouping.
+	GroupingClass GroupingClass
+	GroupingValue uint32
+	GroupingType  GroupingType
+
+	// EventsBuffer is the size of the Events channel, 0 means no channel.
+	EventsBuffer int
+	// Overflow is the policy when the Events channel is full.
+	Overflow EventOverflow
+
+	// ResubscribeInterval is the connection health check interval for automatic re-subscription,
+	// 0 means no automatic re-subscription.
+	ResubscribeInterval time.Duration
 }
 
 // Cannot pass *Subscription to C, so pass an uint64 that points to this map entry
@@ -151,13 +283,15 @@ func CallbackSubscr(ctx unsafe.Pointer, message *C.dpiSubscrMessage) {
 	}
 
 	evt := Event{
-		Err:     err,
-		Type:    EventType(message.eventType),
-		DB:      C.GoStringN(message.dbName, C.int(message.dbNameLength)),
-		Tables:  getTables(message.tables, message.numTables),
-		Queries: getQueries(message.queries, message.numQueries),
+		Err:        err,
+		Type:       EventType(message.eventType),
+		DB:         C.GoStringN(message.dbName, C.int(message.dbNameLength)),
+		Tables:     getTables(message.tables, message.numTables),
+		Queries:    getQueries(message.queries, message.numQueries),
+		Registered: message.registered != 0,
 	}
-	subscr.callback(evt)
+	subscr.deliver(evt)
+	subscr.maybeResubscribe(evt)
 }
 
 // Event for a subscription.
@@ -167,8 +301,14 @@ type Event struct {
 	Tables  []TableEvent
 	Queries []QueryEvent
 	Type    EventType
+	// Registered is false if the subscription is not registered anymore in the database,
+	// either due to a timeout, SubscrDeregisterOnNotification, or an explicit deregistration.
+	Registered bool
 }
 
+// IsDeregister reports whether this event is (or accompanies) the deregistration of the subscription.
+func (e Event) IsDeregister() bool { return e.Type == EvtDeregister || !e.Registered }
+
 // QueryEvent is an event of a Query.
 type QueryEvent struct {
 	Tables []TableEvent
@@ -191,37 +331,150 @@ type RowEvent struct {
 
 // Subscription for events in the DB.
 type Subscription struct {
-	conn      *conn
-	dpiSubscr *C.dpiSubscr
-	callback  func(Event)
-	ID        uint64
+	conn        *conn
+	dpiSubscr   *C.dpiSubscr
+	callback    func(Event)
+	events      chan Event
+	keyResolver *KeyResolver
+	// callbackCtx is the C copy of ID, passed to dpiConn_subscribe as the callback context.
+	callbackCtx *C.uint64_t
+	done        chan struct{}
+	resubscr    chan struct{}
+	name        string
+	queries     []subscrQuery
+	params      subscriptionParams
+	ID          uint64
+	dropped     atomic.Uint64
+	sending     sync.WaitGroup
+	mu          sync.RWMutex
+	closeOnce   sync.Once
+	ownConn     bool
+}
+
+type subscrQuery struct {
+	qry    string
+	params []interface{}
+}
+
+// EventOverflow is the policy of what to do when the Subscription.Events channel is full.
+type EventOverflow uint8
+
+const (
+	// OverflowDropNewest drops the event that does not fit into the buffer.
+	OverflowDropNewest = EventOverflow(iota)
+	// OverflowDropOldest drops the oldest event from the buffer to make room for the new one.
+	OverflowDropOldest
+	// OverflowBlock blocks the notification delivery (the OCI thread) till there's room in the buffer.
+	OverflowBlock
+)
+
+// DefaultSubscrEventsBuffer is the default size of the Subscription.Events channel.
+const DefaultSubscrEventsBuffer = 64
+
+// SubscrEvents makes the Subscription deliver the events on the Events() channel,
+// with the given buffer size and overflow policy.
+//
+// This is the default (with DefaultSubscrEventsBuffer and OverflowDropNewest)
+// when NewSubscription is called with a nil callback.
+func SubscrEvents(size int, overflow EventOverflow) SubscriptionOption {
+	return func(p *subscriptionParams) {
+		if size <= 0 {
+			size = DefaultSubscrEventsBuffer
+		}
+		p.EventsBuffer, p.Overflow = size, overflow
+	}
+}
+
+// SubscrAutoResubscribe makes the Subscription re-subscribe on a fresh connection,
+// and re-register all the previously Registered queries,
+// when the subscription is deregistered or the connection is lost.
+//
+// The connection's health is checked with the given interval (defaults to 1 minute).
+//
+// After a successful re-subscription, an EvtResubscribed event is delivered:
+// changes between the loss and this event may have been missed.
+func SubscrAutoResubscribe(checkInterval time.Duration) SubscriptionOption {
+	return func(p *subscriptionParams) {
+		if checkInterval <= 0 {
+			checkInterval = time.Minute
+		}
+		p.ResubscribeInterval = checkInterval
+	}
 }
 
 // NewSubscription creates a new Subscription in the DB.
 //
 // Make sure your user has CHANGE NOTIFICATION privilege!
 //
+// The events are delivered to cb (on an OCI thread, so it should return fast),
+// and/or to the Events() channel, if SubscrEvents is specified or cb is nil.
+//
 // This code is EXPERIMENTAL yet!
 func (c *conn) NewSubscription(name string, cb func(Event), options ...SubscriptionOption) (*Subscription, error) {
 	if !c.params.EnableEvents {
 		return nil, errors.New("subscription must be allowed by specifying \"enableEvents=1\" in the connection parameters")
 	}
-	var p subscriptionParams
+	p := subscriptionParams{QOS: defaultSubscrQOS, Operations: OpAll}
 	for _, o := range options {
 		o(&p)
 	}
-	subscr := Subscription{conn: c, callback: cb}
+	if cb == nil && p.EventsBuffer == 0 {
+		p.EventsBuffer = DefaultSubscrEventsBuffer
+	}
+	subscr := &Subscription{callback: cb, name: name, params: p, done: make(chan struct{})}
+	if p.EventsBuffer != 0 {
+		subscr.events = make(chan Event, p.EventsBuffer)
+	}
+	// cannot pass &subscr to C, so pass indirectly
+	subscriptionsMu.Lock()
+	subscriptionsID++
+	subscr.ID = subscriptionsID
+	subscriptions[subscr.ID] = subscr
+	subscriptionsMu.Unlock()
+
+	subscr.callbackCtx = (*C.uint64_t)(C.malloc(C.sizeof_uint64_t))
+	*subscr.callbackCtx = C.uint64_t(subscr.ID)
+
+	dpiSubscr, err := subscr.subscribe(c)
+	if err != nil {
+		subscriptionsMu.Lock()
+		delete(subscriptions, subscr.ID)
+		subscriptionsMu.Unlock()
+		C.free(unsafe.Pointer(subscr.callbackCtx))
+		return nil, err
+	}
+	subscr.mu.Lock()
+	subscr.conn, subscr.dpiSubscr = c, dpiSubscr
+	subscr.mu.Unlock()
+	if p.ResubscribeInterval != 0 {
+		subscr.resubscr = make(chan struct{}, 1)
+		go subscr.watch()
+	}
+	return subscr, nil
+}
+
+// subscribe creates a dpiSubscr on the given connection.
+func (s *Subscription) subscribe(c *conn) (*C.dpiSubscr, error) {
+	p := s.params
 	params := (*C.dpiSubscrCreateParams)(C.malloc(C.sizeof_dpiSubscrCreateParams))
 	defer func() { C.free(unsafe.Pointer(params)) }()
 	C.dpiContext_initSubscrCreateParams(c.drv.dpiContext, params)
 	params.subscrNamespace = C.DPI_SUBSCR_NAMESPACE_DBCHANGE
 	params.protocol = C.DPI_SUBSCR_PROTO_CALLBACK
-	params.qos = C.DPI_SUBSCR_QOS_BEST_EFFORT | C.DPI_SUBSCR_QOS_QUERY | C.DPI_SUBSCR_QOS_ROWIDS
-	params.operations = C.DPI_OPCODE_ALL_OPS
-	if name != "" || p.IPAddress != "" {
-		if name != "" {
-			params.name = C.CString(name)
-			params.nameLength = C.uint32_t(len(name))
+	params.qos = C.dpiSubscrQOS(p.QOS)
+	params.operations = C.dpiOpCode(p.Operations)
+	if p.Timeout > 0 {
+		params.timeout = C.uint32_t(p.Timeout / time.Second)
+	}
+	if p.GroupingClass != 0 {
+		params.groupingClass = C.uint8_t(p.GroupingClass)
+		params.groupingValue = C.uint32_t(p.GroupingValue)
+		params.groupingType = C.uint8_t(p.GroupingType)
+	}
+	if s.name != "" || p.IPAddress != "" {
+		if s.name != "" {
+			params.name = C.CString(s.name)
+			params.nameLength = C.uint32_t(len(s.name))
 		}
 		if p.IPAddress != "" {
 			params.ipAddress = C.CString(p.IPAddress)
@@ -244,36 +497,236 @@ func (c *conn) NewSubscription(name string, cb func(Event), options ...Subscript
 	}
 	// typedef void (*dpiSubscrCallback)(void* context, dpiSubscrMessage *message);
 	params.callback = C.dpiSubscrCallback(C.CallbackSubscrDebug)
-	// cannot pass &subscr to C, so pass indirectly
-	subscriptionsMu.Lock()
-	subscriptionsID++
-	subscr.ID = subscriptionsID
-	subscriptions[subscr.ID] = &subscr
-	subscriptionsMu.Unlock()
-	subscrID := (*C.uint64_t)(C.malloc(8))
-	*subscrID = C.uint64_t(subscriptionsID)
-	params.callbackContext = unsafe.Pointer(subscrID)
-
-	dpiSubscr := (*C.dpiSubscr)(C.malloc(C.sizeof_void))
+	params.callbackContext = unsafe.Pointer(s.callbackCtx)
 
+	var dpiSubscr *C.dpiSubscr
 	if err := c.checkExec(func() C.int {
-		return C.dpiConn_subscribe(c.dpiConn, params, (**C.dpiSubscr)(unsafe.Pointer(&dpiSubscr)))
+		return C.dpiConn_subscribe(c.dpiConn, params, &dpiSubscr)
 	}); err != nil {
-		C.free(unsafe.Pointer(dpiSubscr))
 		err = fmt.Errorf("newSubscription: %w", err)
 		if strings.Contains(errors.Unwrap(err).Error(), "DPI-1065:") {
 			err = fmt.Errorf("specify \"enableEvents=1\" connection parameter on connection to be able to use subscriptions: %w", err)
 		}
 		return nil, err
 	}
-	subscr.dpiSubscr = dpiSubscr
-	return &subscr, nil
+	return dpiSubscr, nil
+}
+
+// unsubscribe the dpiSubscr. It must not be called while holding s.mu,
+// as it waits for the running callbacks, which need s.mu.RLock in deliver.
+func unsubscribe(c *conn, dpiSubscr *C.dpiSubscr) error {
+	if dpiSubscr == nil || c == nil || c.dpiConn == nil {
+		return nil
+	}
+	return c.checkExec(func() C.int { return C.dpiConn_unsubscribe(c.dpiConn, dpiSubscr) })
+}
+
+// Events returns the channel the events are delivered on.
+//
+// It is nil if the Subscription has been created with a callback and without SubscrEvents,
+// and is closed when the Subscription is closed.
+func (s *Subscription) Events() <-chan Event { return s.events }
+
+// Dropped returns the number of events dropped due to the Events channel being full.
+func (s *Subscription) Dropped() uint64 { return s.dropped.Load() }
+
+// deliver the event to the callback and/or the Events channel.
+func (s *Subscription) deliver(evt Event) {
+	if s == nil {
+		return
+	}
+	if s.isClosed() {
+		return
+	}
+	// The callback may call Close, so it must be called without holding s.mu.
+	s.mu.RLock()
+	callback := s.callback
+	s.mu.RUnlock()
+	if callback != nil {
+		callback(evt)
+	}
+	// Close closes the Events channel only after the running sends finished,
+	// so the send can block without holding s.mu, which Register needs.
+	s.mu.RLock()
+	if s.events == nil || s.isClosed() {
+		s.mu.RUnlock()
+		return
+	}
+	events, overflow := s.events, s.params.Overflow
+	s.sending.Add(1)
+	s.mu.RUnlock()
+	defer s.sending.Done()
+
+	switch overflow {
+	case OverflowBlock:
+		select {
+		case events <- evt:
+		case <-s.done:
+		}
+	case OverflowDropOldest:
+		for sent := false; !sent; {
+			select {
+			case events <- evt:
+				sent = true
+			default:
+				select {
+				case <-events:
+					s.dropped.Add(1)
+				default:
+				}
+			}
+		}
+	default:
+		select {
+		case events <- evt:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// maybeResubscribe signals the watcher on deregistration and shutdown events.
+func (s *Subscription) maybeResubscribe(evt Event) {
+	if s == nil || s.resubscr == nil {
+		return
+	}
+	// With SubscrDeregisterOnNotification, the deregistration after the notification is requested.
+	deregistered := evt.Type == EvtDeregister || !evt.Registered && s.params.QOS&SubscrQOSDeregNfy == 0
+	if deregistered || evt.Type == EvtShutdown || evt.Type == EvtShutdownAny {
+		select {
+		case s.resubscr <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// watch the subscription's connection and re-subscribe when needed.
+func (s *Subscription) watch() {
+	ticker := time.NewTicker(s.params.ResubscribeInterval)
+	defer ticker.Stop()
+	var failing bool
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.resubscr:
+		case <-ticker.C:
+			if !failing && s.isHealthy() {
+				continue
+			}
+		}
+		err := s.resubscribe(context.Background())
+		failing = err != nil
+		logger := getLogger(context.TODO())
+		if err != nil {
+			if logger != nil {
+				logger.Error("resubscribe", "id", s.ID, "error", err)
+			}
+			s.deliver(Event{Type: EvtDeregister, Err: err})
+			continue
+		}
+		if logger != nil {
+			logger.Info("resubscribed", "id", s.ID)
+		}
+		s.deliver(Event{Type: EvtResubscribed, Registered: true})
+	}
+}
+
+func (s *Subscription) isHealthy() bool {
+	s.mu.RLock()
+	c := s.conn
+	s.mu.RUnlock()
+	if c == nil {
+		return false
+	}
+	c.mu.RLock()
+	ok := c.dpiConn != nil
+	c.mu.RUnlock()
+	return ok && c.isHealthy()
+}
+
+// resubscribe on a fresh connection, and re-register the queries.
+func (s *Subscription) resubscribe(ctx context.Context) error {
+	if s.isClosed() {
+		return nil
+	}
+	s.mu.RLock()
+	old := s.conn
+	s.mu.RUnlock()
+	if old == nil {
+		return errors.New("subscription is closed")
+	}
+	c, err := old.drv.createConnFromParams(ctx, old.params)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+
+	s.mu.Lock()
+	if s.isClosed() || s.conn != old {
+		s.mu.Unlock()
+		return c.Close()
+	}
+	oldSubscr, ownConn := s.dpiSubscr, s.ownConn
+	s.conn, s.dpiSubscr, s.ownConn = c, nil, true
+	s.mu.Unlock()
+
+	_ = unsubscribe(old, oldSubscr)
+	if ownConn {
+		_ = old.Close()
+	}
+	dpiSubscr, err := s.subscribe(c)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	if s.isClosed() || s.conn != c {
+		s.mu.Unlock()
+		return unsubscribe(c, dpiSubscr)
+	}
+	s.dpiSubscr = dpiSubscr
+	queries := s.queries[:len(s.queries):len(s.queries)]
+	// Close may unsubscribe concurrently, keep dpiSubscr alive till the registrations end.
+	C.dpiSubscr_addRef(dpiSubscr)
+	s.mu.Unlock()
+	defer C.dpiSubscr_release(dpiSubscr)
+
+	for _, q := range queries {
+		if err := register(c, dpiSubscr, q.qry, q.params...); err != nil {
+			return fmt.Errorf("register %q: %w", q.qry, err)
+		}
+	}
+	return nil
+}
+
+func (s *Subscription) isClosed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
 }
 
 // Register a query for Change Notification.
 //
+// The registered queries are re-registered by SubscrAutoResubscribe.
+//
 // This code is EXPERIMENTAL yet!
 func (s *Subscription) Register(qry string, params ...interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dpiSubscr == nil {
+		return errors.New("subscription is closed")
+	}
+	if err := register(s.conn, s.dpiSubscr, qry, params...); err != nil {
+		return err
+	}
+	s.queries = append(s.queries, subscrQuery{qry: qry, params: params})
+	return nil
+}
+
+// register the query on the dpiSubscr.
+func register(c *conn, dpiSubscr *C.dpiSubscr, qry string, params ...interface{}) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
@@ -281,19 +734,19 @@ func (s *Subscription) Register(qry string, params ...interface{}) error {
 	defer C.free(unsafe.Pointer(cQry))
 
 	var dpiStmt *C.dpiStmt
-	if C.dpiSubscr_prepareStmt(s.dpiSubscr, cQry, C.uint32_t(len(qry)), &dpiStmt) == C.DPI_FAILURE {
-		return fmt.Errorf("prepareStmt[%p]: %w", s.dpiSubscr, s.conn.getError())
+	if C.dpiSubscr_prepareStmt(dpiSubscr, cQry, C.uint32_t(len(qry)), &dpiStmt) == C.DPI_FAILURE {
+		return fmt.Errorf("prepareStmt[%p]: %w", dpiSubscr, c.getError())
 	}
 	defer func() { C.dpiStmt_release(dpiStmt) }()
 
 	mode := C.dpiExecMode(C.DPI_MODE_EXEC_DEFAULT)
 	var qCols C.uint32_t
 	if C.dpiStmt_execute(dpiStmt, mode, &qCols) == C.DPI_FAILURE {
-		return fmt.Errorf("executeStmt: %w", s.conn.getError())
+		return fmt.Errorf("executeStmt: %w", c.getError())
 	}
 	var queryID C.uint64_t
 	if C.dpiStmt_getSubscrQueryId(dpiStmt, &queryID) == C.DPI_FAILURE {
-		return fmt.Errorf("getSubscrQueryId: %w", s.conn.getError())
+		return fmt.Errorf("getSubscrQueryId: %w", c.getError())
 	}
 	logger := getLogger(context.TODO())
 	if logger != nil {
@@ -307,20 +760,41 @@ func (s *Subscription) Register(qry string, params ...interface{}) error {
 //
 // This code is EXPERIMENTAL yet!
 func (s *Subscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() { err = s.close() })
+	return err
+}
+
+func (s *Subscription) close() error {
 	subscriptionsMu.Lock()
 	delete(subscriptions, s.ID)
 	subscriptionsMu.Unlock()
+	close(s.done)
+
+	s.mu.Lock()
 	dpiSubscr := s.dpiSubscr
-	conn := s.conn
+	conn, ownConn := s.conn, s.ownConn
 	s.conn = nil
 	s.dpiSubscr = nil
 	s.callback = nil
-	if dpiSubscr == nil || conn == nil || conn.dpiConn == nil {
-		return nil
+	s.mu.Unlock()
+	// deliver does not start new sends after done is closed.
+	s.sending.Wait()
+	if s.events != nil {
+		close(s.events)
+	}
+
+	if ownConn {
+		defer conn.Close()
 	}
-	if err := conn.checkExec(func() C.int { return C.dpiConn_unsubscribe(conn.dpiConn, dpiSubscr) }); err != nil {
+	if err := unsubscribe(conn, dpiSubscr); err != nil {
+		// The callbacks may still use callbackCtx, so do not free it.
 		return fmt.Errorf("close: %w", err)
 	}
+	if s.callbackCtx != nil {
+		C.free(unsafe.Pointer(s.callbackCtx))
+		s.callbackCtx = nil
+	}
 	return nil
 }
 
@@ -332,12 +806,45 @@ const (
 	EvtStartup     = EventType(C.DPI_EVENT_STARTUP)
 	EvtShutdown    = EventType(C.DPI_EVENT_SHUTDOWN)
 	EvtShutdownAny = EventType(C.DPI_EVENT_SHUTDOWN_ANY)
-	EvtDereg       = EventType(C.DPI_EVENT_DEREG)
+	EvtDeregister  = EventType(C.DPI_EVENT_DEREG)
 	EvtObjChange   = EventType(C.DPI_EVENT_OBJCHANGE)
 	EvtQueryChange = EventType(C.DPI_EVENT_QUERYCHANGE)
 	EvtAQ          = EventType(C.DPI_EVENT_AQ)
+
+	// EvtResubscribed is sent by godror (not the database) after a successful
+	// automatic re-subscription (see SubscrAutoResubscribe).
+	EvtResubscribed = EventType(1 << 16)
+
+	// EvtDereg is the old name of EvtDeregister.
+	//
+	// Deprecated: use EvtDeregister.
+	EvtDereg = EvtDeregister
 )
 
+// String returns the name of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EvtStartup:
+		return "startup"
+	case EvtShutdown:
+		return "shutdown"
+	case EvtShutdownAny:
+		return "shutdown_any"
+	case EvtDeregister:
+		return "deregister"
+	case EvtObjChange:
+		return "objchange"
+	case EvtQueryChange:
+		return "querychange"
+	case EvtAQ:
+		return "aq"
+	case EvtResubscribed:
+		return "resubscribed"
+	default:
+		return fmt.Sprintf("EventType(%d)", uint32(t))
+	}
+}
+
 // Operation in the DB.
 type Operation C.dpiOpCode
 
@@ -0,0 +1,241 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChangedKey is a changed row, identified by its key column values.
+type ChangedKey struct {
+	// Key holds the values of the key columns, in the order of KeyResolver.Keys.
+	// It is nil if the key cannot be determined (deleted row without a fallback, or OpAllRows).
+	Key   []interface{}
+	Rowid string
+	Operation
+}
+
+// Deleted reports whether the row has been deleted.
+func (ck ChangedKey) Deleted() bool { return ck.Operation&OpDelete != 0 }
+
+// DeletedKeys is the fallback for the deleted rows (OpDelete), and the rows that cannot be found by their ROWID.
+//
+// It gets the table name, the key columns and the missing rows, and returns the ChangedKeys for them.
+type DeletedKeys func(ctx context.Context, db Querier, table string, columns []string, rows []RowEvent) ([]ChangedKey, error)
+
+// KeyResolver resolves the ROWIDs of the subscription Events to the key column values.
+//
+// Use a separate connection (pool) for DB, as the subscription's connection
+// must not be used for queries concurrently.
+type KeyResolver struct {
+	// DB is used to query the key columns.
+	DB Querier
+	// Keys maps the table names (OWNER.TABLE, as in TableEvent.Name) to the key columns.
+	// Tables not in Keys are skipped.
+	Keys map[string][]string
+	// Deleted is the fallback for the deleted rows, and the rows not found by ROWID.
+	// If nil, such rows are returned with nil Key.
+	Deleted DeletedKeys
+	// BatchSize is the maximum number of ROWIDs queried at once, defaults to (and maximized in) 1000.
+	BatchSize int
+}
+
+// SetKeyResolver sets the KeyResolver used by ChangedKeys.
+func (s *Subscription) SetKeyResolver(r *KeyResolver) {
+	s.mu.Lock()
+	s.keyResolver = r
+	s.mu.Unlock()
+}
+
+// ChangedKeys resolves the rows of the event to ChangedKeys, per table,
+// using the KeyResolver set by SetKeyResolver.
+func (s *Subscription) ChangedKeys(ctx context.Context, evt Event) (map[string][]ChangedKey, error) {
+	s.mu.RLock()
+	r := s.keyResolver
+	s.mu.RUnlock()
+	if r == nil {
+		return nil, errors.New("no KeyResolver set")
+	}
+	return r.Resolve(ctx, evt)
+}
+
+// Resolve the rows of the event to ChangedKeys, per table.
+//
+// Tables changed as a whole (OpAllRows, or without row information)
+// get one ChangedKey with a nil Key.
+func (r *KeyResolver) Resolve(ctx context.Context, evt Event) (map[string][]ChangedKey, error) {
+	if r.DB == nil {
+		return nil, errors.New("nil DB")
+	}
+	keys := make(map[string][]string, len(r.Keys))
+	for k, v := range r.Keys {
+		keys[strings.ToUpper(k)] = v
+	}
+	batchSize := r.BatchSize
+	if batchSize <= 0 || batchSize > 1000 {
+		batchSize = 1000
+	}
+	result := make(map[string][]ChangedKey)
+	for table, tes := range eventTables(evt) {
+		columns, ok := keys[strings.ToUpper(table)]
+		if !ok {
+			continue
+		}
+		var rows []RowEvent
+		for _, te := range tes {
+			if te.Operation&OpAllRows != 0 || len(te.Rows) == 0 {
+				result[table] = append(result[table], ChangedKey{Operation: te.Operation})
+				continue
+			}
+			rows = append(rows, te.Rows...)
+		}
+		for len(rows) != 0 {
+			n := len(rows)
+			if n > batchSize {
+				n = batchSize
+			}
+			cks, err := r.resolve(ctx, table, columns, rows[:n])
+			if err != nil {
+				return result, fmt.Errorf("%s: %w", table, err)
+			}
+			result[table] = append(result[table], cks...)
+			rows = rows[n:]
+		}
+	}
+	return result, nil
+}
+
+// resolve one batch of rows of the table.
+func (r *KeyResolver) resolve(ctx context.Context, table string, columns []string, rows []RowEvent) ([]ChangedKey, error) {
+	// A deleted row is not there to be looked up by its ROWID,
+	// which may even have been reused by a new row since.
+	var lookup, missing []RowEvent
+	for _, row := range rows {
+		if row.Operation&OpDelete != 0 {
+			missing = append(missing, row)
+		} else {
+			lookup = append(lookup, row)
+		}
+	}
+	cks := make([]ChangedKey, 0, len(rows))
+	if len(lookup) != 0 {
+		found, err := queryKeys(ctx, r.DB, rowidKeysQry(table, columns, len(lookup), ""), columns, lookup)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range lookup {
+			if key, ok := found[row.Rowid]; ok {
+				cks = append(cks, ChangedKey{Key: key, Rowid: row.Rowid, Operation: row.Operation})
+			} else {
+				missing = append(missing, row)
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return cks, nil
+	}
+	if r.Deleted == nil {
+		for _, row := range missing {
+			cks = append(cks, ChangedKey{Rowid: row.Rowid, Operation: row.Operation})
+		}
+		return cks, nil
+	}
+	dks, err := r.Deleted(ctx, r.DB, table, columns, missing)
+	return append(cks, dks...), err
+}
+
+// FlashbackKeys returns a DeletedKeys fallback that reads the keys of the deleted rows
+// with a flashback query, as of the given duration ago.
+//
+// This needs FLASHBACK privilege on the table and enough UNDO retention.
+// Rows not found even this way are returned with nil Key.
+func FlashbackKeys(ago time.Duration) DeletedKeys {
+	asOf := "AS OF TIMESTAMP (SYSTIMESTAMP - NUMTODSINTERVAL(" + strconv.FormatFloat(ago.Seconds(), 'f', -1, 64) + ", 'SECOND'))"
+	return func(ctx context.Context, db Querier, table string, columns []string, rows []RowEvent) ([]ChangedKey, error) {
+		found, err := queryKeys(ctx, db, rowidKeysQry(table, columns, len(rows), asOf), columns, rows)
+		if err != nil {
+			return nil, err
+		}
+		cks := make([]ChangedKey, len(rows))
+		for i, row := range rows {
+			cks[i] = ChangedKey{Key: found[row.Rowid], Rowid: row.Rowid, Operation: row.Operation}
+		}
+		return cks, nil
+	}
+}
+
+// eventTables collects the TableEvents of the Event (directly or from its QueryEvents), per table name.
+func eventTables(evt Event) map[string][]TableEvent {
+	m := make(map[string][]TableEvent)
+	for _, te := range evt.Tables {
+		m[te.Name] = append(m[te.Name], te)
+	}
+	for _, qe := range evt.Queries {
+		for _, te := range qe.Tables {
+			m[te.Name] = append(m[te.Name], te)
+		}
+	}
+	return m
+}
+
+// rowidKeysQry returns the query for the key columns of n rows, identified by ROWID.
+func rowidKeysQry(table string, columns []string, n int, asOf string) string {
+	var buf strings.Builder
+	buf.WriteString("SELECT ROWIDTOCHAR(ROWID)")
+	for _, c := range columns {
+		buf.WriteString(", ")
+		buf.WriteString(c)
+	}
+	buf.WriteString(" FROM ")
+	buf.WriteString(table)
+	if asOf != "" {
+		buf.WriteByte(' ')
+		buf.WriteString(asOf)
+	}
+	buf.WriteString(" WHERE ROWID IN (")
+	for i := 1; i <= n; i++ {
+		if i != 1 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("CHARTOROWID(:")
+		buf.WriteString(strconv.Itoa(i))
+		buf.WriteByte(')')
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+func queryKeys(ctx context.Context, db Querier, qry string, columns []string, rows []RowEvent) (map[string][]interface{}, error) {
+	params := make([]interface{}, len(rows))
+	for i, row := range rows {
+		params[i] = row.Rowid
+	}
+	rs, err := db.QueryContext(ctx, qry, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", qry, err)
+	}
+	defer rs.Close()
+	found := make(map[string][]interface{}, len(rows))
+	for rs.Next() {
+		var rowid string
+		key := make([]interface{}, len(columns))
+		dest := make([]interface{}, 1+len(columns))
+		dest[0] = &rowid
+		for i := range key {
+			dest[i+1] = &key[i]
+		}
+		if err := rs.Scan(dest...); err != nil {
+			return found, fmt.Errorf("%s: %w", qry, err)
+		}
+		found[rowid] = key
+	}
+	return found, rs.Err()
+}
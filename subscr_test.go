@@ -0,0 +1,204 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionOptions(t *testing.T) {
+	t.Parallel()
+	for name, tC := range map[string]struct {
+		options []SubscriptionOption
+		want    subscriptionParams
+	}{
+		"default": {want: subscriptionParams{QOS: defaultSubscrQOS, Operations: OpAll}},
+		"reliable": {
+			options: []SubscriptionOption{SubscrReliable(true), SubscrDeregisterOnNotification(true)},
+			want:    subscriptionParams{QOS: SubscrQOSReliable | SubscrQOSDeregNfy | SubscrQOSQuery | SubscrQOSRowids, Operations: OpAll},
+		},
+		"table": {
+			options: []SubscriptionOption{SubscrQueryLevel(false), SubscrRowids(false), SubscrOperations(OpInsert, OpDelete)},
+			want:    subscriptionParams{QOS: SubscrQOSBestEffort, Operations: OpInsert | OpDelete},
+		},
+		"grouping": {
+			options: []SubscriptionOption{SubscrTimeout(time.Minute), SubscrGrouping(GroupingClassTime, 10, GroupingTypeSummary)},
+			want: subscriptionParams{QOS: defaultSubscrQOS, Operations: OpAll, Timeout: time.Minute,
+				GroupingClass: GroupingClassTime, GroupingValue: 10, GroupingType: GroupingTypeSummary},
+		},
+		"sub-second timeout": {
+			options: []SubscriptionOption{SubscrTimeout(time.Millisecond)},
+			want:    subscriptionParams{QOS: defaultSubscrQOS, Operations: OpAll, Timeout: time.Second},
+		},
+		"fractional timeout": {
+			options: []SubscriptionOption{SubscrTimeout(1500 * time.Millisecond)},
+			want:    subscriptionParams{QOS: defaultSubscrQOS, Operations: OpAll, Timeout: 2 * time.Second},
+		},
+	} {
+		p := subscriptionParams{QOS: defaultSubscrQOS, Operations: OpAll}
+		for _, o := range tC.options {
+			o(&p)
+		}
+		if p != tC.want {
+			t.Errorf("%s: got %+v, wanted %+v", name, p, tC.want)
+		}
+	}
+}
+
+func TestSubscriptionDeliver(t *testing.T) {
+	t.Parallel()
+	for _, tC := range []struct {
+		name    string
+		want    []uint64
+		policy  EventOverflow
+		dropped uint64
+	}{
+		{name: "newest", policy: OverflowDropNewest, want: []uint64{0, 1}, dropped: 2},
+		{name: "oldest", policy: OverflowDropOldest, want: []uint64{2, 3}, dropped: 2},
+	} {
+		s := &Subscription{
+			params: subscriptionParams{Overflow: tC.policy},
+			events: make(chan Event, 2), done: make(chan struct{}),
+		}
+		for i := uint64(0); i < 4; i++ {
+			s.deliver(Event{Queries: []QueryEvent{{ID: i}}})
+		}
+		if got := s.Dropped(); got != tC.dropped {
+			t.Errorf("%s: dropped %d, wanted %d", tC.name, got, tC.dropped)
+		}
+		if err := s.Close(); err != nil {
+			t.Fatal(err)
+		}
+		var got []uint64
+		for e := range s.Events() {
+			got = append(got, e.Queries[0].ID)
+		}
+		if len(got) != len(tC.want) || got[0] != tC.want[0] || got[1] != tC.want[1] {
+			t.Errorf("%s: got %v, wanted %v", tC.name, got, tC.want)
+		}
+	}
+}
+
+func TestRowidKeysQry(t *testing.T) {
+	t.Parallel()
+	const want = "SELECT ROWIDTOCHAR(ROWID), ID, SUB FROM DEMO.T WHERE ROWID IN (CHARTOROWID(:1), CHARTOROWID(:2))"
+	if got := rowidKeysQry("DEMO.T", []string{"ID", "SUB"}, 2, ""); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestKeyResolverDeleted(t *testing.T) {
+	t.Parallel()
+	var fallback []RowEvent
+	// No DB: the deleted rows must not be looked up by ROWID.
+	r := KeyResolver{Deleted: func(_ context.Context, _ Querier, _ string, _ []string, rows []RowEvent) ([]ChangedKey, error) {
+		fallback = append(fallback, rows...)
+		cks := make([]ChangedKey, len(rows))
+		for i, row := range rows {
+			cks[i] = ChangedKey{Key: []interface{}{row.Rowid}, Rowid: row.Rowid, Operation: row.Operation}
+		}
+		return cks, nil
+	}}
+	rows := []RowEvent{{Rowid: "a1", Operation: OpDelete}, {Rowid: "a2", Operation: OpDelete | OpUpdate}}
+	cks, err := r.resolve(context.Background(), "DEMO.A", []string{"ID"}, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fallback) != 2 || len(cks) != 2 || cks[1].Rowid != "a2" {
+		t.Errorf("got %+v, fallback %+v", cks, fallback)
+	}
+}
+
+func TestEventTables(t *testing.T) {
+	t.Parallel()
+	evt := Event{
+		Tables: []TableEvent{{Name: "DEMO.A", Rows: []RowEvent{{Rowid: "a1"}}}},
+		Queries: []QueryEvent{{Tables: []TableEvent{
+			{Name: "DEMO.A", Rows: []RowEvent{{Rowid: "a2"}}},
+			{Name: "DEMO.B", Operation: OpAllRows},
+		}}},
+	}
+	m := eventTables(evt)
+	if len(m) != 2 || len(m["DEMO.A"]) != 2 || len(m["DEMO.B"]) != 1 {
+		t.Errorf("got %+v", m)
+	}
+}
+
+func TestSubscriptionCloseFromCallback(t *testing.T) {
+	t.Parallel()
+	s := &Subscription{done: make(chan struct{}), events: make(chan Event, 1)}
+	s.callback = func(Event) { _ = s.Close() }
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		s.deliver(Event{})
+		_ = s.Close() // second Close must not panic
+	}()
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("deliver deadlocked with Close in the callback")
+	}
+	if _, ok := <-s.Events(); ok {
+		t.Error("event delivered after Close")
+	}
+}
+
+func TestSubscriptionRegisterFromConsumer(t *testing.T) {
+	t.Parallel()
+	s := &Subscription{
+		params: subscriptionParams{Overflow: OverflowBlock},
+		events: make(chan Event, 1), done: make(chan struct{}),
+	}
+	s.deliver(Event{})
+	blocked := make(chan struct{})
+	go func() {
+		defer close(blocked)
+		s.deliver(Event{}) // blocks on the full buffer
+	}()
+	time.Sleep(10 * time.Millisecond)
+	registered := make(chan error, 1)
+	go func() {
+		<-s.Events()
+		registered <- s.Register("SELECT 1 FROM DUAL")
+	}()
+	select {
+	case err := <-registered:
+		if err == nil {
+			t.Error("Register on a not subscribed Subscription succeeded")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Register deadlocked with the blocking deliver")
+	}
+	<-blocked
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubscriptionMaybeResubscribe(t *testing.T) {
+	t.Parallel()
+	for _, tC := range []struct {
+		name  string
+		qos   SubscrQOS
+		evt   Event
+		wants bool
+	}{
+		{name: "notRegistered", qos: defaultSubscrQOS, evt: Event{Type: EvtObjChange}, wants: true},
+		{name: "registered", qos: defaultSubscrQOS, evt: Event{Type: EvtObjChange, Registered: true}},
+		{name: "deregNfy", qos: defaultSubscrQOS | SubscrQOSDeregNfy, evt: Event{Type: EvtObjChange}},
+		{name: "dereg", qos: defaultSubscrQOS | SubscrQOSDeregNfy, evt: Event{Type: EvtDeregister}, wants: true},
+		{name: "shutdown", qos: defaultSubscrQOS, evt: Event{Type: EvtShutdown, Registered: true}, wants: true},
+	} {
+		s := &Subscription{params: subscriptionParams{QOS: tC.qos}, resubscr: make(chan struct{}, 1)}
+		s.maybeResubscribe(tC.evt)
+		if got := len(s.resubscr) != 0; got != tC.wants {
+			t.Errorf("%s: got %t, wanted %t", tC.name, got, tC.wants)
+		}
+	}
+}
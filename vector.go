@@ -23,10 +23,21 @@ void* getVectorInfoDimensions(dpiVectorInfo *info) {
 import "C"
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"unsafe"
 )
 
+var (
+	_ sql.Scanner   = (*Vector)(nil)
+	_ driver.Valuer = Vector{}
+)
+
 // Vector holds the embedding VECTOR column starting from 23ai.
 type Vector struct {
 	Dimensions uint32      // Total dimensions of the vector.
@@ -165,3 +176,354 @@ func GetVectorValue(vectorInfo *C.dpiVectorInfo) (Vector, error) {
 		IsSparse:   isSparse,
 	}, nil
 }
+
+// sparse reports whether the vector is in sparse format.
+func (v Vector) sparse() bool { return v.IsSparse || len(v.Indices) != 0 }
+
+// Len returns the number of dimensions of the vector.
+func (v Vector) Len() int {
+	if v.sparse() {
+		return int(v.Dimensions)
+	}
+	switch x := v.Values.(type) {
+	case []float32:
+		return len(x)
+	case []float64:
+		return len(x)
+	case []int8:
+		return len(x)
+	case []uint8:
+		return 8 * len(x)
+	}
+	return int(v.Dimensions)
+}
+
+// Float32s returns the values as a dense []float32.
+//
+// Sparse vectors are expanded to Dimensions, binary vectors are unpacked to 0 or 1 per dimension.
+func (v Vector) Float32s() ([]float32, error) {
+	if x, ok := v.Values.([]float32); ok && !v.sparse() {
+		return x, nil
+	}
+	return denseValues(v, func(f float64) (float32, error) {
+		g := float32(f)
+		if !math.IsInf(f, 0) && math.IsInf(float64(g), 0) {
+			return 0, fmt.Errorf("%v overflows float32", f)
+		}
+		return g, nil
+	})
+}
+
+// Float64s returns the values as a dense []float64.
+//
+// Sparse vectors are expanded to Dimensions, binary vectors are unpacked to 0 or 1 per dimension.
+func (v Vector) Float64s() ([]float64, error) {
+	if x, ok := v.Values.([]float64); ok && !v.sparse() {
+		return x, nil
+	}
+	return denseValues(v, func(f float64) (float64, error) { return f, nil })
+}
+
+// Int8s returns the values as a dense []int8.
+//
+// Sparse vectors are expanded to Dimensions, binary vectors are unpacked to 0 or 1 per dimension.
+// Values that are not integers in the int8 range result in an error.
+func (v Vector) Int8s() ([]int8, error) {
+	if x, ok := v.Values.([]int8); ok && !v.sparse() {
+		return x, nil
+	}
+	return denseValues(v, func(f float64) (int8, error) {
+		if f != math.Trunc(f) || f < math.MinInt8 || f > math.MaxInt8 {
+			return 0, fmt.Errorf("%v cannot be represented as int8", f)
+		}
+		return int8(f), nil
+	})
+}
+
+// Bits returns the values packed as a binary vector: one bit per dimension,
+// set for non-zero values, the first dimension being the most significant bit of the first byte.
+//
+// The number of dimensions must be a multiple of 8.
+func (v Vector) Bits() ([]byte, error) {
+	if x, ok := v.Values.([]uint8); ok && !v.sparse() {
+		return x, nil
+	}
+	fs, err := v.Float64s()
+	if err != nil {
+		return nil, err
+	}
+	if len(fs)%8 != 0 {
+		return nil, fmt.Errorf("binary vector needs a multiple of 8 dimensions, got %d", len(fs))
+	}
+	b := make([]byte, len(fs)/8)
+	for i, f := range fs {
+		if f != 0 {
+			b[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return b, nil
+}
+
+// floatValues returns the (non-zero for sparse) values as float64.
+func floatValues(values interface{}) ([]float64, error) {
+	switch x := values.(type) {
+	case nil:
+		return nil, nil
+	case []float32:
+		fs := make([]float64, len(x))
+		for i, f := range x {
+			fs[i] = float64(f)
+		}
+		return fs, nil
+	case []float64:
+		return x, nil
+	case []int8:
+		fs := make([]float64, len(x))
+		for i, f := range x {
+			fs[i] = float64(f)
+		}
+		return fs, nil
+	case []uint8:
+		fs := make([]float64, 8*len(x))
+		for i := range fs {
+			if x[i/8]&(0x80>>(i%8)) != 0 {
+				fs[i] = 1
+			}
+		}
+		return fs, nil
+	default:
+		return nil, fmt.Errorf("unsupported Vector Values type %T", values)
+	}
+}
+
+// denseValues returns the values of the vector (expanded if sparse), converted with conv.
+func denseValues[T float32 | float64 | int8](v Vector, conv func(float64) (T, error)) ([]T, error) {
+	fs, err := floatValues(v.Values)
+	if err != nil {
+		return nil, err
+	}
+	if !v.sparse() {
+		out := make([]T, len(fs))
+		for i, f := range fs {
+			if out[i], err = conv(f); err != nil {
+				return nil, fmt.Errorf("%d. %w", i, err)
+			}
+		}
+		return out, nil
+	}
+	if len(v.Indices) != len(fs) {
+		return nil, fmt.Errorf("sparse vector has %d indices but %d values", len(v.Indices), len(fs))
+	}
+	out := make([]T, v.Dimensions)
+	for i, idx := range v.Indices {
+		if idx >= v.Dimensions {
+			return nil, fmt.Errorf("index %d out of range (dimensions=%d)", idx, v.Dimensions)
+		}
+		if out[idx], err = conv(fs[i]); err != nil {
+			return nil, fmt.Errorf("%d. %w", idx, err)
+		}
+	}
+	return out, nil
+}
+
+// String returns the textual form of the vector, as Oracle prints it:
+// "[1,2,3]" for dense, "[dimensions,[indices],[values]]" for sparse vectors.
+func (v Vector) String() string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	if v.sparse() {
+		buf.WriteString(strconv.FormatUint(uint64(v.Dimensions), 10))
+		buf.WriteString(",[")
+		for i, idx := range v.Indices {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.FormatUint(uint64(idx), 10))
+		}
+		buf.WriteString("],[")
+	}
+	switch x := v.Values.(type) {
+	case []float32:
+		for i, f := range x {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+		}
+	case []float64:
+		for i, f := range x {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	case []int8:
+		for i, n := range x {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.Itoa(int(n)))
+		}
+	case []uint8:
+		for i, n := range x {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.Itoa(int(n)))
+		}
+	}
+	if v.sparse() {
+		buf.WriteByte(']')
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// ParseVector parses the textual form of a vector:
+// "[1.0,2.0]" for dense, "[dimensions,[indices],[values]]" for sparse vectors.
+//
+// The values are parsed as float64.
+func ParseVector(s string) (Vector, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return Vector{}, fmt.Errorf("vector %q: not in [...]", s)
+	}
+	inner := strings.TrimSpace(s[1 : len(s)-1])
+	i := strings.IndexByte(inner, '[')
+	if i < 0 {
+		fs, err := parseFloats(inner)
+		if err != nil {
+			return Vector{}, fmt.Errorf("vector %q: %w", s, err)
+		}
+		return Vector{Values: fs}, nil
+	}
+
+	dimStr, rest, ok := strings.Cut(inner, ",")
+	if !ok {
+		return Vector{}, fmt.Errorf("sparse vector %q: no dimensions", s)
+	}
+	dims, err := strconv.ParseUint(strings.TrimSpace(dimStr), 10, 32)
+	if err != nil {
+		return Vector{}, fmt.Errorf("sparse vector %q: dimensions: %w", s, err)
+	}
+	idxStr, rest, err := cutBracketed(rest)
+	if err != nil {
+		return Vector{}, fmt.Errorf("sparse vector %q: indices: %w", s, err)
+	}
+	if rest, ok = strings.CutPrefix(strings.TrimSpace(rest), ","); !ok {
+		return Vector{}, fmt.Errorf("sparse vector %q: no values", s)
+	}
+	valStr, rest, err := cutBracketed(rest)
+	if err != nil {
+		return Vector{}, fmt.Errorf("sparse vector %q: values: %w", s, err)
+	} else if strings.TrimSpace(rest) != "" {
+		return Vector{}, fmt.Errorf("sparse vector %q: trailing %q", s, rest)
+	}
+	var indices []uint32
+	if idxStr = strings.TrimSpace(idxStr); idxStr != "" {
+		for _, t := range strings.Split(idxStr, ",") {
+			idx, err := strconv.ParseUint(strings.TrimSpace(t), 10, 32)
+			if err != nil {
+				return Vector{}, fmt.Errorf("sparse vector %q: index %q: %w", s, t, err)
+			}
+			if idx >= dims {
+				return Vector{}, fmt.Errorf("sparse vector %q: index %d out of range", s, idx)
+			}
+			indices = append(indices, uint32(idx))
+		}
+	}
+	fs, err := parseFloats(valStr)
+	if err != nil {
+		return Vector{}, fmt.Errorf("sparse vector %q: %w", s, err)
+	}
+	if len(fs) != len(indices) {
+		return Vector{}, fmt.Errorf("sparse vector %q: %d indices but %d values", s, len(indices), len(fs))
+	}
+	return Vector{Dimensions: uint32(dims), Indices: indices, Values: fs, IsSparse: true}, nil
+}
+
+// cutBracketed cuts the leading "[...]" from s, returning its inside and the rest.
+func cutBracketed(s string) (inside, rest string, err error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") {
+		return "", s, errors.New("missing [")
+	}
+	i := strings.IndexByte(s, ']')
+	if i < 0 {
+		return "", s, errors.New("missing ]")
+	}
+	return s[1:i], s[i+1:], nil
+}
+
+func parseFloats(s string) ([]float64, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return []float64{}, nil
+	}
+	parts := strings.Split(s, ",")
+	fs := make([]float64, len(parts))
+	for i, t := range parts {
+		var err error
+		if fs[i], err = strconv.ParseFloat(strings.TrimSpace(t), 64); err != nil {
+			return nil, fmt.Errorf("%d. value: %w", i, err)
+		}
+	}
+	return fs, nil
+}
+
+// MarshalText returns the textual form of the vector, see String.
+func (v Vector) MarshalText() ([]byte, error) { return []byte(v.String()), nil }
+
+// UnmarshalText parses the textual form of the vector, see ParseVector.
+func (v *Vector) UnmarshalText(p []byte) error {
+	w, err := ParseVector(string(p))
+	if err != nil {
+		return err
+	}
+	*v = w
+	return nil
+}
+
+// Scan the value into the Vector: accepts Vector, the textual form (string or []byte),
+// and []float32, []float64, []int8 as dense vectors.
+func (v *Vector) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case nil:
+		*v = Vector{}
+	case Vector:
+		*v = x
+	case *Vector:
+		if x == nil {
+			*v = Vector{}
+		} else {
+			*v = *x
+		}
+	case string:
+		return v.UnmarshalText([]byte(x))
+	case []byte:
+		return v.UnmarshalText(x)
+	case []float32, []float64, []int8:
+		*v = Vector{Values: x}
+	default:
+		return fmt.Errorf("cannot scan %T into Vector", src)
+	}
+	return nil
+}
+
+// Value returns the textual form of the vector, for drivers that do not know Vector.
+//
+// This driver binds Vector natively.
+func (v Vector) Value() (driver.Value, error) {
+	if v.Values == nil {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// vectorFromSlice returns the slice as a dense Vector, if it is a []float32, []float64 or []int8.
+func vectorFromSlice(value interface{}) (Vector, bool) {
+	switch value.(type) {
+	case []float32, []float64, []int8:
+		return Vector{Values: value}, true
+	}
+	return Vector{}, false
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DistanceMetric is a vector distance metric, as in VECTOR_DISTANCE.
+type DistanceMetric uint8
+
+const (
+	// DistanceCosine is 1 - the cosine similarity.
+	DistanceCosine = DistanceMetric(iota + 1)
+	// DistanceDot is the negated dot product.
+	DistanceDot
+	// DistanceEuclidean is the square root of the sum of the squared differences.
+	DistanceEuclidean
+	// DistanceEuclideanSquared is the sum of the squared differences.
+	DistanceEuclideanSquared
+	// DistanceManhattan is the sum of the absolute differences.
+	DistanceManhattan
+	// DistanceHamming is the number of differing dimensions (bits for BINARY vectors).
+	DistanceHamming
+	// DistanceJaccard is 1 - |A∩B| / |A∪B|, for BINARY vectors only.
+	DistanceJaccard
+)
+
+// String returns the name of the metric as used in VECTOR_DISTANCE.
+func (m DistanceMetric) String() string {
+	switch m {
+	case DistanceCosine:
+		return "COSINE"
+	case DistanceDot:
+		return "DOT"
+	case DistanceEuclidean:
+		return "EUCLIDEAN"
+	case DistanceEuclideanSquared:
+		return "EUCLIDEAN_SQUARED"
+	case DistanceManhattan:
+		return "MANHATTAN"
+	case DistanceHamming:
+		return "HAMMING"
+	case DistanceJaccard:
+		return "JACCARD"
+	default:
+		return fmt.Sprintf("DistanceMetric(%d)", uint8(m))
+	}
+}
+
+// ErrZeroVector is returned for the cosine distance of a zero vector, which is undefined.
+var ErrZeroVector = errors.New("cosine distance of a zero vector is undefined")
+
+// VectorDistance returns the distance of a and b with the given metric,
+// matching the semantics of Oracle's VECTOR_DISTANCE (also for sparse vectors).
+//
+// The vectors must have the same number of dimensions.
+func VectorDistance(a, b Vector, metric DistanceMetric) (float64, error) {
+	switch metric {
+	case DistanceCosine:
+		return CosineDistance(a, b)
+	case DistanceDot:
+		return DotDistance(a, b)
+	case DistanceEuclidean:
+		return EuclideanDistance(a, b)
+	case DistanceEuclideanSquared:
+		var sum float64
+		err := vectorPairs(a, b, func(x, y float64) { sum += (x - y) * (x - y) })
+		return sum, err
+	case DistanceManhattan:
+		return ManhattanDistance(a, b)
+	case DistanceHamming:
+		return HammingDistance(a, b)
+	case DistanceJaccard:
+		return JaccardDistance(a, b)
+	default:
+		return 0, fmt.Errorf("unknown distance metric %d", metric)
+	}
+}
+
+// CosineDistance returns 1 - the cosine similarity of a and b.
+func CosineDistance(a, b Vector) (float64, error) {
+	var dot, aa, bb float64
+	if err := vectorPairs(a, b, func(x, y float64) {
+		dot += x * y
+		aa += x * x
+		bb += y * y
+	}); err != nil {
+		return 0, err
+	}
+	if aa == 0 || bb == 0 {
+		return 0, ErrZeroVector
+	}
+	return 1 - dot/(math.Sqrt(aa)*math.Sqrt(bb)), nil
+}
+
+// DotDistance returns the negated dot product of a and b.
+func DotDistance(a, b Vector) (float64, error) {
+	var dot float64
+	err := vectorPairs(a, b, func(x, y float64) { dot += x * y })
+	return -dot, err
+}
+
+// EuclideanDistance returns the Euclidean (L2) distance of a and b.
+func EuclideanDistance(a, b Vector) (float64, error) {
+	var sum float64
+	err := vectorPairs(a, b, func(x, y float64) { sum += (x - y) * (x - y) })
+	return math.Sqrt(sum), err
+}
+
+// ManhattanDistance returns the Manhattan (L1) distance of a and b.
+func ManhattanDistance(a, b Vector) (float64, error) {
+	var sum float64
+	err := vectorPairs(a, b, func(x, y float64) { sum += math.Abs(x - y) })
+	return sum, err
+}
+
+// HammingDistance returns the number of dimensions where a and b differ.
+// For BINARY vectors, this is the number of differing bits.
+func HammingDistance(a, b Vector) (float64, error) {
+	var n int
+	err := vectorPairs(a, b, func(x, y float64) {
+		if x != y {
+			n++
+		}
+	})
+	return float64(n), err
+}
+
+// JaccardDistance returns 1 - |A∩B| / |A∪B| of the BINARY vectors a and b.
+func JaccardDistance(a, b Vector) (float64, error) {
+	if _, ok := a.Values.([]uint8); !ok {
+		return 0, fmt.Errorf("JACCARD needs BINARY vectors, got %T", a.Values)
+	}
+	if _, ok := b.Values.([]uint8); !ok {
+		return 0, fmt.Errorf("JACCARD needs BINARY vectors, got %T", b.Values)
+	}
+	var intersection, union int
+	if err := vectorPairs(a, b, func(x, y float64) {
+		if x != 0 && y != 0 {
+			intersection++
+		}
+		if x != 0 || y != 0 {
+			union++
+		}
+	}); err != nil {
+		return 0, err
+	}
+	if union == 0 {
+		return 0, nil
+	}
+	return 1 - float64(intersection)/float64(union), nil
+}
+
+// vectorPairs calls f with the values of a and b for (at least) each dimension where any of them is non-zero.
+func vectorPairs(a, b Vector, f func(x, y float64)) error {
+	if n, m := a.Len(), b.Len(); n != m {
+		return fmt.Errorf("vector dimensions differ (%d != %d)", n, m)
+	}
+	if a.sparse() && b.sparse() && sortedIndices(a.Indices) && sortedIndices(b.Indices) {
+		av, err := floatValues(a.Values)
+		if err != nil {
+			return err
+		}
+		bv, err := floatValues(b.Values)
+		if err != nil {
+			return err
+		}
+		if len(av) != len(a.Indices) || len(bv) != len(b.Indices) {
+			return errors.New("sparse vector indices and values differ in length")
+		}
+		// merge the two sorted index lists
+		i, j := 0, 0
+		for i < len(a.Indices) || j < len(b.Indices) {
+			switch {
+			case j >= len(b.Indices) || i < len(a.Indices) && a.Indices[i] < b.Indices[j]:
+				f(av[i], 0)
+				i++
+			case i >= len(a.Indices) || b.Indices[j] < a.Indices[i]:
+				f(0, bv[j])
+				j++
+			default:
+				f(av[i], bv[j])
+				i++
+				j++
+			}
+		}
+		return nil
+	}
+	av, err := a.Float64s()
+	if err != nil {
+		return err
+	}
+	bv, err := b.Float64s()
+	if err != nil {
+		return err
+	}
+	for i := range av {
+		f(av[i], bv[i])
+	}
+	return nil
+}
+
+func sortedIndices(indices []uint32) bool {
+	for i := 1; i < len(indices); i++ {
+		if indices[i-1] >= indices[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Neighbor is one result of TopK: the index of the candidate and its distance.
+type Neighbor struct {
+	Index    int
+	Distance float64
+}
+
+// TopK returns the k candidates nearest to query (ordered by ascending distance,
+// ties broken by the candidate index), as ORDER BY VECTOR_DISTANCE(...) FETCH FIRST k ROWS ONLY would.
+//
+// k <= 0 means all candidates.
+func TopK(query Vector, candidates []Vector, k int, metric DistanceMetric) ([]Neighbor, error) {
+	if k <= 0 || k > len(candidates) {
+		k = len(candidates)
+	}
+	if k == 0 {
+		return nil, nil
+	}
+	h := make(neighborHeap, 0, k+1)
+	for i, c := range candidates {
+		d, err := VectorDistance(query, c, metric)
+		if err != nil {
+			return nil, fmt.Errorf("%d. %w", i, err)
+		}
+		nb := Neighbor{Index: i, Distance: d}
+		if len(h) < k {
+			heap.Push(&h, nb)
+		} else if nb.less(h[0]) {
+			h[0] = nb
+			heap.Fix(&h, 0)
+		}
+	}
+	sort.Slice(h, func(i, j int) bool { return h[i].less(h[j]) })
+	return h, nil
+}
+
+func (nb Neighbor) less(other Neighbor) bool {
+	return nb.Distance < other.Distance || nb.Distance == other.Distance && nb.Index < other.Index
+}
+
+// neighborHeap is a max-heap: the root is the farthest of the nearest k.
+type neighborHeap []Neighbor
+
+func (h neighborHeap) Len() int            { return len(h) }
+func (h neighborHeap) Less(i, j int) bool  { return h[j].less(h[i]) }
+func (h neighborHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *neighborHeap) Push(x interface{}) { *h = append(*h, x.(Neighbor)) }
+func (h *neighborHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
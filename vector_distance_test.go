@@ -0,0 +1,87 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestVectorDistance(t *testing.T) {
+	a := Vector{Values: []float32{1, 2, 3}}
+	b := Vector{Values: []float64{4, 0, -1}}
+	sa := Vector{Dimensions: 3, Indices: []uint32{0, 1, 2}, Values: []float32{1, 2, 3}, IsSparse: true}
+	sb := Vector{Dimensions: 3, Indices: []uint32{0, 2}, Values: []float64{4, -1}, IsSparse: true}
+	for _, tc := range []struct {
+		Metric DistanceMetric
+		Want   float64
+	}{
+		{DistanceCosine, 1 - 1/(math.Sqrt(14)*math.Sqrt(17))},
+		{DistanceDot, -1},
+		{DistanceEuclidean, math.Sqrt(9 + 4 + 16)},
+		{DistanceEuclideanSquared, 29},
+		{DistanceManhattan, 9},
+		{DistanceHamming, 3},
+	} {
+		for _, pair := range [][2]Vector{{a, b}, {sa, sb}, {a, sb}, {sa, b}} {
+			got, err := VectorDistance(pair[0], pair[1], tc.Metric)
+			if err != nil {
+				t.Fatalf("%s: %+v", tc.Metric, err)
+			}
+			if math.Abs(got-tc.Want) > 1e-12 {
+				t.Errorf("%s(%v, %v): got %v, wanted %v", tc.Metric, pair[0], pair[1], got, tc.Want)
+			}
+		}
+	}
+
+	if _, err := CosineDistance(a, Vector{Values: []float32{0, 0, 0}}); !errors.Is(err, ErrZeroVector) {
+		t.Errorf("cosine of zero vector: got %v", err)
+	}
+	if _, err := DotDistance(a, Vector{Values: []float32{1, 2}}); err == nil {
+		t.Error("dimension mismatch succeeded")
+	}
+
+	x, y := Vector{Values: []uint8{0xf0}}, Vector{Values: []uint8{0x3c}}
+	if got, err := HammingDistance(x, y); err != nil {
+		t.Fatal(err)
+	} else if got != 4 {
+		t.Errorf("hamming: got %v, wanted 4", got)
+	}
+	if got, err := JaccardDistance(x, y); err != nil {
+		t.Fatal(err)
+	} else if want := 1 - 2.0/6; math.Abs(got-want) > 1e-12 {
+		t.Errorf("jaccard: got %v, wanted %v", got, want)
+	}
+	if _, err := JaccardDistance(a, a); err == nil {
+		t.Error("jaccard of FLOAT32 succeeded")
+	}
+}
+
+func TestTopK(t *testing.T) {
+	q := Vector{Values: []float32{0, 0}}
+	candidates := []Vector{
+		{Values: []float32{3, 0}},
+		{Values: []float32{1, 0}},
+		{Values: []float32{0, 2}},
+		{Values: []float32{0, 1}},
+		{Values: []float32{5, 5}},
+	}
+	got, err := TopK(q, candidates, 3, DistanceEuclidean)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Neighbor{{Index: 1, Distance: 1}, {Index: 3, Distance: 1}, {Index: 2, Distance: 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+	if all, err := TopK(q, candidates, 0, DistanceManhattan); err != nil {
+		t.Fatal(err)
+	} else if len(all) != len(candidates) || all[len(all)-1].Index != 4 {
+		t.Errorf("all: got %v", all)
+	}
+}
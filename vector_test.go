@@ -0,0 +1,97 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVectorAccessors(t *testing.T) {
+	sparse := Vector{Dimensions: 5, Indices: []uint32{0, 3}, Values: []float32{1.5, -2}, IsSparse: true}
+	if got, err := sparse.Float64s(); err != nil {
+		t.Fatal(err)
+	} else if want := []float64{1.5, 0, 0, -2, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Float64s: got %v, wanted %v", got, want)
+	}
+	if _, err := sparse.Int8s(); err == nil {
+		t.Error("Int8s of 1.5 succeeded")
+	}
+
+	dense := Vector{Values: []int8{1, 0, -3, 0, 0, 0, 0, 7}}
+	if got, err := dense.Float32s(); err != nil {
+		t.Fatal(err)
+	} else if want := []float32{1, 0, -3, 0, 0, 0, 0, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Float32s: got %v, wanted %v", got, want)
+	}
+	if got, err := dense.Bits(); err != nil {
+		t.Fatal(err)
+	} else if want := []byte{0xa1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Bits: got %x, wanted %x", got, want)
+	}
+
+	binary := Vector{Values: []uint8{0xa1}}
+	if got, err := binary.Int8s(); err != nil {
+		t.Fatal(err)
+	} else if want := []int8{1, 0, 1, 0, 0, 0, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Int8s: got %v, wanted %v", got, want)
+	}
+	if n := binary.Len(); n != 8 {
+		t.Errorf("Len: got %d, wanted 8", n)
+	}
+}
+
+func TestVectorText(t *testing.T) {
+	for _, tc := range []struct {
+		In   Vector
+		Text string
+	}{
+		{In: Vector{Values: []float64{1, 2.5, -3}}, Text: "[1,2.5,-3]"},
+		{In: Vector{Values: []float64{}}, Text: "[]"},
+		{In: Vector{Dimensions: 10, Indices: []uint32{1, 7}, Values: []float64{0.5, 4}, IsSparse: true}, Text: "[10,[1,7],[0.5,4]]"},
+	} {
+		if got := tc.In.String(); got != tc.Text {
+			t.Errorf("String: got %q, wanted %q", got, tc.Text)
+		}
+		got, err := ParseVector(tc.Text)
+		if err != nil {
+			t.Fatalf("%q: %+v", tc.Text, err)
+		}
+		if !reflect.DeepEqual(got, tc.In) {
+			t.Errorf("ParseVector(%q): got %#v, wanted %#v", tc.Text, got, tc.In)
+		}
+	}
+
+	if got := (Vector{Values: []float32{0.1}}).String(); got != "[0.1]" {
+		t.Errorf("float32: got %q", got)
+	}
+	if got, err := ParseVector(" [ 1.0E+000 , 2 ] "); err != nil {
+		t.Fatal(err)
+	} else if want := []float64{1, 2}; !reflect.DeepEqual(got.Values, want) {
+		t.Errorf("got %v, wanted %v", got.Values, want)
+	}
+	for _, s := range []string{"", "1,2", "[a]", "[3,[0,1],[1]]", "[3,[5],[1]]", "[3,[0]]"} {
+		if _, err := ParseVector(s); err == nil {
+			t.Errorf("ParseVector(%q) succeeded", s)
+		}
+	}
+
+	var v Vector
+	if err := v.Scan("[4,[2],[1]]"); err != nil {
+		t.Fatal(err)
+	}
+	if fs, err := v.Float32s(); err != nil {
+		t.Fatal(err)
+	} else if want := []float32{0, 0, 1, 0}; !reflect.DeepEqual(fs, want) {
+		t.Errorf("Scan: got %v, wanted %v", fs, want)
+	}
+	if err := v.Scan([]float64{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	if val, err := v.Value(); err != nil || val != "[1,2]" {
+		t.Errorf("Value: got %v, %v", val, err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Godror Authors
+//
+//
+// SPDX-License-Identifier: UPL-1.0 OR Apache-2.0
+
+package godror_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	godror "github.com/godror/godror"
+)
+
+func TestDualityView(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("DualityView"), 30*time.Second)
+	defer cancel()
+	tbl, view := "test_dv_dept"+tblSuffix, "test_dv"+tblSuffix
+	testDb.ExecContext(ctx, "DROP VIEW "+view)
+	testDb.ExecContext(ctx, "DROP TABLE "+tbl)
+	if _, err := testDb.ExecContext(ctx, "CREATE TABLE "+tbl+" (deptno NUMBER(6) PRIMARY KEY, dname VARCHAR2(30))"); err != nil { //nolint:gas
+		t.Fatal(err)
+	}
+	defer testDb.ExecContext(context.Background(), "DROP TABLE "+tbl) //nolint:gas
+	if _, err := testDb.ExecContext(ctx,
+		"CREATE JSON RELATIONAL DUALITY VIEW "+view+ //nolint:gas
+			" AS SELECT JSON {'_id': d.deptno, 'name': d.dname} FROM "+tbl+" d WITH INSERT UPDATE DELETE",
+	); err != nil {
+		// 23ai is needed
+		t.Skip(err)
+	}
+	defer testDb.ExecContext(context.Background(), "DROP VIEW "+view) //nolint:gas
+
+	type Dept struct {
+		ID       int                    `json:"_id"`
+		Name     string                 `json:"name"`
+		Metadata godror.DualityMetadata `json:"_metadata,omitempty"`
+	}
+	dv := godror.DualityView{Name: view}
+	if err := dv.Insert(ctx, testDb, Dept{ID: 1, Name: "Sales"}); err != nil {
+		t.Fatal(err)
+	}
+	var a, b Dept
+	if err := dv.Get(ctx, testDb, 1, &a); err != nil {
+		t.Fatal(err)
+	}
+	if err := dv.Get(ctx, testDb, 1, &b); err != nil {
+		t.Fatal(err)
+	}
+	if a.Name != "Sales" || a.Metadata.ETag == "" {
+		t.Fatalf("got %+v", a)
+	}
+
+	a.Name = "Marketing"
+	if err := dv.Update(ctx, testDb, 1, a); err != nil {
+		t.Fatal(err)
+	}
+	b.Name = "Research"
+	if err := dv.Update(ctx, testDb, 1, b); !errors.Is(err, godror.ErrETagMismatch) {
+		t.Fatalf("stale update: got %v, wanted ErrETagMismatch", err)
+	}
+	if err := dv.Delete(ctx, testDb, 1, b.Metadata.ETag); !errors.Is(err, godror.ErrETagMismatch) {
+		t.Fatalf("stale delete: got %v, wanted ErrETagMismatch", err)
+	}
+
+	var c Dept
+	if err := dv.Modify(ctx, testDb, 1, &c, func() error { c.Name += "!"; return nil }, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := dv.Get(ctx, testDb, 1, &c); err != nil {
+		t.Fatal(err)
+	} else if c.Name != "Marketing!" {
+		t.Errorf("got %q, wanted Marketing!", c.Name)
+	}
+
+	if err := dv.Delete(ctx, testDb, 1, c.Metadata.ETag); err != nil {
+		t.Fatal(err)
+	}
+	if err := dv.Get(ctx, testDb, 1, &c); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("deleted: got %v, wanted sql.ErrNoRows", err)
+	}
+	if err := dv.Update(ctx, testDb, 1, c); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("update deleted: got %v, wanted sql.ErrNoRows", err)
+	}
+}
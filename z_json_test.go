@@ -6,6 +6,7 @@
 package godror_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -847,3 +848,328 @@ func errIs(err error, code int, msg string) bool {
 	}
 	return msg != "" && strings.Contains(err.Error(), msg)
 }
+
+func TestJSONMarshalUnmarshalStruct(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("JSONMarshalUnmarshalStruct"), 30*time.Second)
+	defer cancel()
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	tbl := "test_json_struct" + tblSuffix
+	conn.ExecContext(ctx, "DROP TABLE "+tbl)
+	if _, err = conn.ExecContext(ctx,
+		"CREATE TABLE "+tbl+" (id NUMBER(6), jdoc JSON)", //nolint:gas
+	); err != nil {
+		if errIs(err, 902, "invalid datatype") {
+			t.Skip(err)
+		}
+		t.Fatal(err)
+	}
+	defer testDb.Exec("DROP TABLE " + tbl) //nolint:gas
+
+	type Address struct {
+		City string `json:"city"`
+		Zip  string `json:"zip,omitempty"`
+	}
+	type Person struct {
+		ID        int64         `json:"id"`
+		Name      string        `json:"name"`
+		Born      time.Time     `json:"born"`
+		Commute   time.Duration `json:"commute"`
+		Salary    godror.Number `json:"salary"`
+		Photo     []byte        `json:"photo"`
+		Addresses []Address     `json:"addresses"`
+		Manager   *Person       `json:"manager,omitempty"`
+		Tags      map[string]int
+	}
+	in := Person{
+		ID: 1, Name: "Mary", Born: birthdate, Commute: 35 * time.Minute,
+		Salary: "45.23", Photo: []byte{0, 1, 2},
+		Addresses: []Address{{City: "Budapest", Zip: "1111"}, {City: "Szeged"}},
+		Manager:   &Person{ID: 2, Name: "John", Born: birthdate, Salary: "100"},
+		Tags:      map[string]int{"a": 1},
+	}
+	jv, err := godror.JSONMarshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.ExecContext(ctx, "INSERT INTO "+tbl+" (id, jdoc) VALUES (:1, :2)", 1, jv); err != nil { //nolint:gas
+		t.Fatal(err)
+	}
+	// struct directly
+	if _, err = conn.ExecContext(ctx, "INSERT INTO "+tbl+" (id, jdoc) VALUES (:1, :2)", 2, godror.JSONValue{Value: in}); err != nil { //nolint:gas
+		t.Fatal(err)
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT jdoc FROM "+tbl+" ORDER BY id") //nolint:gas
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var j godror.JSON
+		if err = rows.Scan(&j); err != nil {
+			t.Fatal(err)
+		}
+		var out Person
+		if err = godror.JSONUnmarshal(j, &out); err != nil {
+			t.Fatal(err)
+		}
+		if out.Name != in.Name || !out.Born.Equal(in.Born) || out.Commute != in.Commute ||
+			out.Salary != in.Salary || !bytes.Equal(out.Photo, in.Photo) ||
+			len(out.Addresses) != 2 || out.Addresses[0] != in.Addresses[0] ||
+			out.Manager == nil || out.Manager.Name != "John" || out.Tags["a"] != 1 {
+			t.Errorf("got %#v, wanted %#v", out, in)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	rows.Close()
+
+	// a 19-digit NUMBER through JSONObject.Unmarshal
+	const bigQry = `SELECT JSON('{"n":1234567890123456789}') FROM DUAL`
+	if rows, err = conn.QueryContext(ctx, bigQry); err != nil {
+		t.Fatalf("%s: %+v", bigQry, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatalf("%s: %+v", bigQry, rows.Err())
+	}
+	var j godror.JSON
+	if err = rows.Scan(&j); err != nil {
+		t.Fatal(err)
+	}
+	var big struct {
+		N int64 `json:"n"`
+	}
+	obj, err := j.GetJSONObject(godror.JSONOptNumberAsString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = obj.Unmarshal(&big); err != nil {
+		t.Fatal(err)
+	} else if big.N != 1234567890123456789 {
+		t.Errorf("got %d, wanted 1234567890123456789", big.N)
+	}
+}
+
+func TestOSONColumn(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("OSONColumn"), 30*time.Second)
+	defer cancel()
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	tbl := "test_oson_blob" + tblSuffix
+	conn.ExecContext(ctx, "DROP TABLE "+tbl)
+	if _, err = conn.ExecContext(ctx,
+		"CREATE TABLE "+tbl+" (id NUMBER(6), jdoc BLOB CHECK (jdoc IS JSON FORMAT OSON))", //nolint:gas
+	); err != nil {
+		// IS JSON FORMAT OSON needs 21c
+		t.Skip(err)
+	}
+	defer testDb.Exec("DROP TABLE " + tbl) //nolint:gas
+
+	doc := map[string]interface{}{
+		"name": "Mary", "age": godror.Number("42"), "salary": godror.Number("-1234.5"),
+		"born":  time.Date(1980, 2, 3, 4, 5, 6, 0, time.Local),
+		"tags":  []interface{}{"a", true, nil},
+		"photo": []byte{0, 1, 2},
+	}
+	b, err := godror.EncodeOSON(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.ExecContext(ctx, "INSERT INTO "+tbl+" (id, jdoc) VALUES (:1, :2)", 1, b); err != nil { //nolint:gas
+		t.Fatal(err)
+	}
+	var name string
+	var raw []byte
+	if err = conn.QueryRowContext(ctx,
+		"SELECT JSON_VALUE(jdoc, '$.name'), jdoc FROM "+tbl+" WHERE id = 1", //nolint:gas
+	).Scan(&name, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if name != "Mary" {
+		t.Errorf("JSON_VALUE got %q, wanted Mary", name)
+	}
+	got, err := godror.DecodeOSON(raw, godror.JSONOptNumberAsString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, doc) {
+		t.Errorf("got %#v, wanted %#v", got, doc)
+	}
+}
+
+// BenchmarkJSONvsOSON compares fetching JSON documents through the C dpiJsonNode tree
+// and fetching the OSON image as BLOB and decoding it in Go.
+func BenchmarkJSONvsOSON(b *testing.B) {
+	ctx, cancel := context.WithTimeout(testContext("JSONvsOSON"), 5*time.Minute)
+	defer cancel()
+	tbl := "test_oson_bench" + tblSuffix
+	testDb.ExecContext(ctx, "DROP TABLE "+tbl)
+	if _, err := testDb.ExecContext(ctx,
+		"CREATE TABLE "+tbl+" (id NUMBER(6), jdoc JSON, odoc BLOB CHECK (odoc IS JSON FORMAT OSON))", //nolint:gas
+	); err != nil {
+		b.Skip(err)
+	}
+	defer testDb.Exec("DROP TABLE " + tbl) //nolint:gas
+
+	const rowCount = 100
+	for i := 0; i < rowCount; i++ {
+		items := make([]interface{}, 20)
+		for j := range items {
+			items[j] = map[string]interface{}{"sku": fmt.Sprintf("s-%d-%d", i, j), "qty": j, "price": godror.Number("1.25")}
+		}
+		doc := map[string]interface{}{"id": i, "customer": "Árvíztűrő", "items": items, "created": time.Now()}
+		img, err := godror.EncodeOSON(doc)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err = testDb.ExecContext(ctx, "INSERT INTO "+tbl+" (id, jdoc, odoc) VALUES (:1, :2, :3)", //nolint:gas
+			i, godror.JSONValue{Value: doc}, img); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.Run("JSON", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rows, err := testDb.QueryContext(ctx, "SELECT jdoc FROM "+tbl) //nolint:gas
+			if err != nil {
+				b.Fatal(err)
+			}
+			for rows.Next() {
+				var j godror.JSON
+				if err = rows.Scan(&j); err != nil {
+					b.Fatal(err)
+				}
+				if _, err = j.GetValue(godror.JSONOptNumberAsString); err != nil {
+					b.Fatal(err)
+				}
+			}
+			rows.Close()
+		}
+	})
+	b.Run("OSON", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rows, err := testDb.QueryContext(ctx, "SELECT odoc FROM "+tbl) //nolint:gas
+			if err != nil {
+				b.Fatal(err)
+			}
+			for rows.Next() {
+				var img []byte
+				if err = rows.Scan(&img); err != nil {
+					b.Fatal(err)
+				}
+				if _, err = godror.DecodeOSON(img, godror.JSONOptNumberAsString); err != nil {
+					b.Fatal(err)
+				}
+			}
+			rows.Close()
+		}
+	})
+}
+
+func TestJSONDecoder(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("JSONDecoder"), 30*time.Second)
+	defer cancel()
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	tbl := "test_json_decoder" + tblSuffix
+	conn.ExecContext(ctx, "DROP TABLE "+tbl)
+	if _, err = conn.ExecContext(ctx,
+		"CREATE TABLE "+tbl+" (id NUMBER(6), jdoc JSON)", //nolint:gas
+	); err != nil {
+		if errIs(err, 902, "invalid datatype") {
+			t.Skip(err)
+		}
+		t.Fatal(err)
+	}
+	defer testDb.Exec("DROP TABLE " + tbl) //nolint:gas
+
+	items := make([]interface{}, 1000)
+	for i := range items {
+		items[i] = map[string]interface{}{"sku": fmt.Sprintf("s-%04d", i), "qty": i}
+	}
+	doc := map[string]interface{}{
+		"created": birthdate, "wait": 90 * time.Second, "raw": []byte{1, 2, 3},
+		"items": items, "customer": "Mary",
+	}
+	if _, err = conn.ExecContext(ctx, "INSERT INTO "+tbl+" (id, jdoc) VALUES (:1, :2)", 1, godror.JSONValue{Value: doc}); err != nil { //nolint:gas
+		t.Fatal(err)
+	}
+	var j godror.JSON
+	if err = conn.QueryRowContext(ctx, "SELECT jdoc FROM "+tbl+" WHERE id = 1").Scan(&j); err != nil { //nolint:gas
+		t.Fatal(err)
+	}
+	dec, err := j.NewDecoder(godror.JSONOptNumberAsString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dec.Token(); err != nil {
+		t.Fatal(err)
+	}
+	var customer string
+	var lastSKU string
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch key {
+		case "customer":
+			if err = dec.Decode(&customer); err != nil {
+				t.Fatal(err)
+			}
+		case "created":
+			if tok, err := dec.Token(); err != nil {
+				t.Fatal(err)
+			} else if tm, ok := tok.(time.Time); !ok || !tm.Equal(birthdate) {
+				t.Errorf("%s: got %#v, wanted %v", dec.Path(), tok, birthdate)
+			}
+		case "wait":
+			if tok, err := dec.Token(); err != nil || tok != 90*time.Second {
+				t.Errorf("%s: got %#v (%v)", dec.Path(), tok, err)
+			}
+		case "raw":
+			if tok, err := dec.Token(); err != nil || !bytes.Equal(tok.([]byte), []byte{1, 2, 3}) {
+				t.Errorf("%s: got %#v (%v)", dec.Path(), tok, err)
+			}
+		case "items":
+			if _, err = dec.Token(); err != nil {
+				t.Fatal(err)
+			}
+			for dec.More() {
+				var item struct {
+					SKU string `json:"sku"`
+				}
+				if err = dec.Decode(&item); err != nil {
+					t.Fatal(err)
+				}
+				lastSKU = item.SKU
+			}
+			if _, err = dec.Token(); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			if err = dec.Skip(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if customer != "Mary" || lastSKU != "s-0999" {
+		t.Errorf("got customer=%q lastSKU=%q", customer, lastSKU)
+	}
+}
@@ -656,4 +656,259 @@ func TestCLOBSurrogate(t *testing.T) {
 		}
 	})
 
+	t.Run("Seek", func(t *testing.T) {
+		rows, err = testDb.QueryContext(ctx, qry, godror.LobAsReader())
+		if err != nil {
+			t.Fatalf("%s: %+v", qry, err)
+		}
+		defer rows.Close()
+		// "aáñ⅛" is 4, the musical note is 2 UCS-2 code units
+		const oneLen = 6
+		for rows.Next() {
+			var id godror.Number
+			var clobI, nclobI any
+			if err := rows.Scan(&id, &nclobI, &clobI); err != nil {
+				t.Fatalf("scan %s: %+v", qry, err)
+			}
+			nclob := nclobI.(*godror.Lob)
+			defer nclob.Close()
+			size, err := nclob.CharSize()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size != count*oneLen {
+				t.Errorf("CharSize: got %d, wanted %d", size, count*oneLen)
+			}
+			if _, err = nclob.Seek(oneLen*(count/2), io.SeekStart); err != nil {
+				t.Fatal(err)
+			}
+			var got []rune
+			for i := 0; i < 2*len([]rune(one)); i++ {
+				r, _, err := nclob.ReadRune()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, r)
+			}
+			if want := one + one; string(got) != want {
+				t.Errorf("ReadRune after Seek: got %q, wanted %q", string(got), want)
+			}
+			if pos, err := nclob.Seek(0, io.SeekCurrent); err != nil {
+				t.Fatal(err)
+			} else if want := int64(oneLen * (count/2 + 2)); pos != want {
+				t.Errorf("position: got %d, wanted %d", pos, want)
+			}
+			// read only whole characters, even from the middle of a repetition
+			p := make([]byte, 4*5)
+			n, err := nclob.ReadAt(p, oneLen*(count-1)+1)
+			if err != nil && err != io.EOF {
+				t.Fatal(err)
+			}
+			if want := one[1:]; string(p[:n]) != want {
+				t.Errorf("ReadAt: got %q, wanted %q", p[:n], want)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+}
+
+func TestBFile(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("BFile"), 30*time.Second)
+	defer cancel()
+	const dir, name = "DATA_PUMP_DIR", "godror-nonexistent.txt"
+
+	var bf godror.BFile
+	if err := testDb.QueryRowContext(ctx, "SELECT BFILENAME(:1, :2) FROM DUAL", dir, name).Scan(&bf); err != nil {
+		t.Fatal(err)
+	}
+	defer bf.Close()
+	if bf.Dir != dir || bf.Name != name {
+		t.Errorf("got %q/%q, wanted %q/%q", bf.Dir, bf.Name, dir, name)
+	}
+	if ok, err := bf.Exists(); err != nil {
+		t.Skip(err)
+	} else if ok {
+		t.Errorf("%s/%s exists", dir, name)
+	}
+
+	var gotDir, gotName string
+	if _, err := testDb.ExecContext(ctx, "BEGIN DBMS_LOB.FILEGETNAME(:1, :2, :3); END;",
+		godror.BFile{Dir: dir, Name: name}, sql.Out{Dest: &gotDir}, sql.Out{Dest: &gotName},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if gotDir != dir || gotName != name {
+		t.Errorf("IN: got %q/%q, wanted %q/%q", gotDir, gotName, dir, name)
+	}
+
+	var out godror.BFile
+	if _, err := testDb.ExecContext(ctx, "BEGIN :1 := BFILENAME(:2, :3); END;",
+		sql.Out{Dest: &out}, dir, name,
+	); err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	if out.Dir != dir || out.Name != name {
+		t.Errorf("OUT: got %q/%q, wanted %q/%q", out.Dir, out.Name, dir, name)
+	}
+	// the previous locator of out is released
+	if _, err := testDb.ExecContext(ctx, "BEGIN :1 := BFILENAME(:2, :3); END;",
+		sql.Out{Dest: &out}, dir, name+"2",
+	); err != nil {
+		t.Fatal(err)
+	}
+	if out.Name != name+"2" {
+		t.Errorf("OUT again: got %q, wanted %q", out.Name, name+"2")
+	}
+
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := godror.Raw(ctx, conn, func(c godror.Conn) error {
+		nb, err := c.NewBFile(dir, name)
+		if err != nil {
+			return err
+		}
+		defer nb.Close()
+		if ok, err := nb.Exists(); err != nil {
+			return err
+		} else if ok {
+			t.Errorf("%s/%s exists", dir, name)
+		}
+		if _, err = nb.NewReader(); err == nil {
+			t.Error("NewReader succeeded on a nonexistent file")
+		}
+		// the reader has its own locator, so the BFile is still usable
+		if _, err := nb.Exists(); err != nil {
+			t.Errorf("Exists after NewReader: %+v", err)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLobStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("LobStream"), 60*time.Second)
+	defer cancel()
+
+	tbl := "test_lob_stream" + tblSuffix
+	drQry := "DROP TABLE " + tbl
+	_, _ = testDb.ExecContext(ctx, drQry)
+	crQry := "CREATE TABLE " + tbl + " (F_id NUMBER(9) NOT NULL, F_blob BLOB, F_clob CLOB)"
+	if _, err := testDb.ExecContext(ctx, crQry); err != nil {
+		t.Fatal(crQry, err)
+	}
+	defer func() { _, _ = testDb.ExecContext(context.Background(), drQry) }()
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 1<<16+1)
+	var progress []int64
+	bs := godror.LobStream{
+		Reader:   bytes.NewReader(data),
+		Progress: func(n int64) { progress = append(progress, n) },
+	}
+	insQry := "INSERT INTO " + tbl + " (F_id, F_blob) VALUES (:1, EMPTY_BLOB()) RETURNING F_blob INTO :2"
+	if _, err := testDb.ExecContext(ctx, insQry, 1, sql.Out{Dest: &bs}); err != nil {
+		t.Fatalf("%s: %+v", insQry, err)
+	}
+	if bs.Written != int64(len(data)) {
+		t.Errorf("written %d, wanted %d", bs.Written, len(data))
+	}
+	if len(progress) < 2 || progress[len(progress)-1] != bs.Written {
+		t.Errorf("progress: %v", progress)
+	}
+
+	text := strings.Repeat("árvíztűrő tükörfúrógép 😀 ", 1<<14)
+	cs := godror.LobStream{Reader: strings.NewReader(text), IsClob: true, ChunkSize: 1000}
+	updQry := "UPDATE " + tbl + " SET F_clob = EMPTY_CLOB() WHERE F_id = :1 RETURNING F_clob INTO :2"
+	if _, err := testDb.ExecContext(ctx, updQry, 1, sql.Out{Dest: &cs}); err != nil {
+		t.Fatalf("%s: %+v", updQry, err)
+	}
+
+	var gotB []byte
+	var gotC string
+	selQry := "SELECT F_blob, F_clob FROM " + tbl + " WHERE F_id = 1"
+	if err := testDb.QueryRowContext(ctx, selQry).Scan(&gotB, &gotC); err != nil {
+		t.Fatalf("%s: %+v", selQry, err)
+	}
+	if !bytes.Equal(gotB, data) {
+		t.Errorf("BLOB mismatch: got %d, wanted %d bytes", len(gotB), len(data))
+	}
+	if gotC != text {
+		t.Errorf("CLOB mismatch: got %d, wanted %d bytes", len(gotC), len(text))
+	}
+}
+
+func TestDirectLobServerSide(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("DirectLobServerSide"), 30*time.Second)
+	defer cancel()
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var a, b *godror.DirectLob
+	if err = godror.Raw(ctx, conn, func(c godror.Conn) error {
+		if a, err = c.NewTempLob(false); err != nil {
+			return err
+		}
+		if b, err = c.NewTempLob(false); err != nil {
+			return err
+		}
+		if err = a.Set([]byte("Hello, ")); err != nil {
+			return err
+		}
+		return b.Set([]byte("World!"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	defer b.Close()
+
+	if err = a.Append(ctx, conn, b); err != nil {
+		t.Fatal(err)
+	}
+	if size, err := a.Size(); err != nil {
+		t.Fatal(err)
+	} else if size != 13 {
+		t.Errorf("size after Append: got %d, wanted 13", size)
+	}
+	if off, err := a.Instr(ctx, conn, "World", 0, 1); err != nil {
+		t.Fatal(err)
+	} else if off != 7 {
+		t.Errorf("Instr: got %d, wanted 7", off)
+	}
+	if off, err := a.Instr(ctx, conn, "nothing", 0, 1); err != nil {
+		t.Fatal(err)
+	} else if off != -1 {
+		t.Errorf("Instr: got %d, wanted -1", off)
+	}
+	if cmp, err := a.Compare(ctx, conn, b, 6, 7, 0); err != nil {
+		t.Fatal(err)
+	} else if cmp != 0 {
+		t.Errorf("Compare: got %d, wanted 0", cmp)
+	}
+
+	sub, err := a.Substr(ctx, conn, 5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	if err = sub.CopyFrom(ctx, conn, b, 0, 5, 5); err != nil {
+		t.Fatal(err)
+	}
+	got := make([]byte, 16)
+	n, err := sub.ReadAt(got, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		t.Fatal(err)
+	}
+	if s := string(got[:n]); s != "Hello!" {
+		t.Errorf("Substr+CopyFrom: got %q, wanted %q", s, "Hello!")
+	}
 }
@@ -16,6 +16,7 @@ import (
 	"io"
 	"log/slog"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -779,6 +780,104 @@ func TestSelectObjectTable(t *testing.T) {
 	}
 }
 
+func TestDbmsOutputLogger(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	lgr := slog.New(slog.NewTextHandler(&buf, nil))
+	ctx, cancel := context.WithTimeout(testContext("DbmsOutputLogger"), 10*time.Second)
+	defer cancel()
+	ctx = godror.ContextWithDbmsOutput(godror.ContextWithLogger(ctx, lgr), true)
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	const qry = "BEGIN DBMS_OUTPUT.put_line('first'); DBMS_OUTPUT.put_line(:1); END;"
+	if _, err = conn.ExecContext(ctx, qry, "second"); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	t.Log(got)
+	if !strings.Contains(got, "line=first") || !strings.Contains(got, "line=second") {
+		t.Errorf("lines not logged: %q", got)
+	}
+
+	buf.Reset()
+	if _, err = conn.ExecContext(ctx, qry, "third", godror.NoDbmsOutput()); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "" {
+		t.Errorf("logged with NoDbmsOutput: %q", got)
+	}
+	// the next call drains the lines left in the buffer
+	if _, err = conn.ExecContext(ctx, "BEGIN NULL; END;"); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); !strings.Contains(got, "line=third") {
+		t.Errorf("left lines not logged: %q", got)
+	}
+}
+
+func TestCheckCompileErrors(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("CheckCompileErrors"), 30*time.Second)
+	defer cancel()
+	const procName = "test_compile_diag"
+	defer testDb.ExecContext(context.Background(), "DROP PROCEDURE "+procName)
+	const qry = "CREATE OR REPLACE PROCEDURE " + procName + ` IS
+  v_num NUMBER;
+BEGIN
+  v_num := undefined_var;
+END;`
+
+	// Without the option, it is just a warning.
+	if _, err := testDb.ExecContext(ctx, qry); err != nil {
+		t.Fatalf("%s: %+v", qry, err)
+	}
+
+	_, err := testDb.ExecContext(ctx, qry, godror.CheckCompileErrors())
+	if err == nil {
+		t.Fatal("wanted compile error")
+	}
+	t.Log(err)
+	var ec interface{ Code() int }
+	if !errors.As(err, &ec) || ec.Code() != 24344 {
+		t.Errorf("wanted ORA-24344, got %+v", err)
+	}
+	var cd godror.CompileDiagnostics
+	if !errors.As(err, &cd) {
+		t.Fatalf("wanted CompileDiagnostics, got %#v", err)
+	}
+	if len(cd.Errors) == 0 {
+		t.Fatalf("no errors: %+v", cd)
+	}
+	var found bool
+	for _, ce := range cd.Errors {
+		if found = ce.Facility == "PLS" && ce.Code == 201 && ce.Line == 4 &&
+			strings.Contains(ce.Source, "undefined_var") && strings.Contains(ce.Snippet(), "^"); found {
+			break
+		}
+	}
+	if !found {
+		t.Errorf("PLS-00201 not found: %+v", cd.Errors)
+	}
+
+	got, err := godror.GetCompileDiagnostics(ctx, testDb, "", procName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(cd, got); d != "" {
+		t.Error(d)
+	}
+
+	if _, err = testDb.ExecContext(ctx,
+		"CREATE OR REPLACE PROCEDURE "+procName+" IS BEGIN NULL; END;", godror.CheckCompileErrors(),
+	); err != nil {
+		t.Errorf("valid procedure: %+v", err)
+	}
+}
+
 func TestFuncBool(t *testing.T) {
 	t.Parallel()
 	ctx, cancel := context.WithTimeout(testContext("FuncBool"), 3*time.Second)
@@ -944,6 +1043,311 @@ END;`
 		}
 	}
 }
+
+func TestSparseAssocArray(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("SparseAssocArray"), 30*time.Second)
+	defer cancel()
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	testCon, err := godror.DriverConn(ctx, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const crea = `CREATE OR REPLACE PACKAGE test_pkg_sparse IS
+  TYPE num_tab_typ IS TABLE OF NUMBER INDEX BY PLS_INTEGER;
+  TYPE num_by_name_typ IS TABLE OF NUMBER INDEX BY VARCHAR2(30);
+
+  PROCEDURE double(p_tab IN OUT NOCOPY num_tab_typ);
+  PROCEDURE scale(p_map IN OUT NOCOPY num_by_name_typ, p_factor IN NUMBER);
+END;`
+	const crea2 = `CREATE OR REPLACE PACKAGE BODY test_pkg_sparse IS
+  PROCEDURE double(p_tab IN OUT NOCOPY num_tab_typ) IS
+    v_idx PLS_INTEGER := p_tab.FIRST;
+  BEGIN
+    WHILE v_idx IS NOT NULL LOOP
+      p_tab(v_idx) := 2 * p_tab(v_idx);
+      v_idx := p_tab.NEXT(v_idx);
+    END LOOP;
+    p_tab(2000) := 0;
+  END double;
+
+  PROCEDURE scale(p_map IN OUT NOCOPY num_by_name_typ, p_factor IN NUMBER) IS
+    v_in num_by_name_typ := p_map;
+    v_key VARCHAR2(30) := v_in.FIRST;
+  BEGIN
+    p_map.DELETE;
+    WHILE v_key IS NOT NULL LOOP
+      p_map(UPPER(v_key)) := p_factor * v_in(v_key);
+      v_key := v_in.NEXT(v_key);
+    END LOOP;
+  END scale;
+END;`
+	for _, qry := range []string{crea, crea2} {
+		if err = prepExec(ctx, testCon, qry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer testDb.ExecContext(context.Background(), "DROP PACKAGE test_pkg_sparse")
+
+	cOt, err := testCon.GetObjectType("TEST_PKG_SPARSE.NUM_TAB_TYP")
+	if err != nil {
+		t.Skip(err)
+	}
+	coll, err := cOt.NewCollection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer coll.Close()
+	if err = coll.FromIndexMap(map[int32]interface{}{-5: "1", 10: "2", 1000: "3"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = prepExec(ctx, testCon, "BEGIN test_pkg_sparse.double(:1); END;",
+		driver.NamedValue{Ordinal: 1, Value: coll},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := coll.AsIndexMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[int32]string, len(m))
+	for k, v := range m {
+		got[k] = fmt.Sprint(v)
+	}
+	if d := cmp.Diff(map[int32]string{-5: "2", 10: "4", 1000: "6", 2000: "0"}, got); d != "" {
+		t.Error(d)
+	}
+
+	var indices []int
+	for i, err := coll.Last(); err == nil; i, err = coll.Prev(i) {
+		indices = append(indices, i)
+	}
+	if d := cmp.Diff([]int{2000, 1000, 10, -5}, indices); d != "" {
+		t.Errorf("reverse: %s", d)
+	}
+
+	if _, err = testDb.ExecContext(ctx, "BEGIN NULL; END;", map[string]int{"a": 1}); !errors.Is(err, godror.ErrNotSupported) {
+		t.Errorf("map[string]int bind: got %+v, wanted ErrNotSupported", err)
+	}
+
+	nums := godror.StringMap[int]{TypeName: "TEST_PKG_SPARSE.NUM_BY_NAME_TYP", Map: map[string]int{"a": 1, "b": 2, "c": 3}}
+	if _, err = testDb.ExecContext(ctx, "BEGIN test_pkg_sparse.scale(:1, :2); END;",
+		sql.Out{Dest: &nums, In: true}, 10,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(map[string]int{"A": 10, "B": 20, "C": 30}, nums.Map); d != "" {
+		t.Error(d)
+	}
+}
+
+func TestObjectDiff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("ObjectDiff"), 30*time.Second)
+	defer cancel()
+	const typeName = "test_diff"
+	dels := []string{
+		`DROP PROCEDURE ` + typeName + `_modify`,
+		`DROP TYPE ` + typeName + `_ot CASCADE`,
+		`DROP TYPE ` + typeName + `_addr_ot CASCADE`,
+		`DROP TYPE ` + typeName + `_lt CASCADE`,
+	}
+	for _, del := range dels {
+		testDb.ExecContext(ctx, del)
+	}
+	for _, ddl := range []string{
+		`CREATE OR REPLACE TYPE ` + typeName + `_lt FORCE AS VARRAY(10) OF VARCHAR2(20)`,
+		`CREATE OR REPLACE TYPE ` + typeName + `_addr_ot FORCE AS OBJECT (city VARCHAR2(20), zip VARCHAR2(10))`,
+		`CREATE OR REPLACE TYPE ` + typeName + `_ot FORCE AS OBJECT (
+  id NUMBER(9), name VARCHAR2(20), addr ` + typeName + `_addr_ot, tags ` + typeName + `_lt)`,
+		`CREATE OR REPLACE PROCEDURE ` + typeName + `_modify(p_obj IN OUT NOCOPY ` + typeName + `_ot) IS
+BEGIN
+  p_obj.name := 'changed';
+  p_obj.addr.city := 'Szeged';
+  p_obj.tags(2) := 'z';
+  p_obj.tags.EXTEND;
+  p_obj.tags(p_obj.tags.LAST) := 'new';
+END;`,
+	} {
+		if _, err := testDb.ExecContext(ctx, ddl); err != nil {
+			t.Fatalf("%s: %+v", ddl, err)
+		}
+	}
+	defer func() {
+		for _, del := range dels {
+			_, _ = testDb.ExecContext(context.Background(), del)
+		}
+	}()
+
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	testCon, err := godror.DriverConn(ctx, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ot, err := testCon.GetObjectType(typeName + "_ot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := ot.NewObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	addr, err := ot.Attributes["ADDR"].ObjectType.NewObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer addr.Close()
+	tags, err := ot.Attributes["TAGS"].ObjectType.NewCollection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tags.Close()
+	for _, s := range []string{"a", "b", "c"} {
+		if err = tags.Append(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = addr.Set("CITY", "Budapest"); err != nil {
+		t.Fatal(err)
+	}
+	if err = addr.Set("ZIP", "1111"); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range map[string]interface{}{"ID": 1, "NAME": "orig", "ADDR": addr, "TAGS": tags} {
+		if err = obj.Set(k, v); err != nil {
+			t.Fatalf("%s: %+v", k, err)
+		}
+	}
+
+	before, err := obj.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer before.Close()
+	if !before.Equal(obj) {
+		changes, err := before.Diff(obj)
+		t.Fatalf("copy is not equal: %v %+v", changes, err)
+	}
+
+	if err = prepExec(ctx, testCon, "BEGIN "+typeName+"_modify(:1); END;",
+		driver.NamedValue{Ordinal: 1, Value: obj},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if before.Equal(obj) {
+		t.Error("modified object is equal to the original")
+	}
+	changes, err := before.Diff(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("changes:", changes)
+	want := []godror.AttrChange{
+		{Path: "NAME", Old: "orig", New: "changed"},
+		{Path: "ADDR.CITY", Old: "Budapest", New: "Szeged"},
+		{Path: "TAGS[1]", Old: "b", New: "z"},
+		{Path: "TAGS[3]", New: "new"},
+	}
+	if d := cmp.Diff(want, changes); d != "" {
+		t.Error(d)
+	}
+}
+
+func TestGenericCollection(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("GenericCollection"), 30*time.Second)
+	defer cancel()
+	const typeName = "test_generic"
+	dels := []string{
+		`DROP PROCEDURE ` + typeName + `_modify`,
+		`DROP TYPE ` + typeName + `_grp CASCADE`,
+		`DROP TYPE ` + typeName + `_tab CASCADE`,
+		`DROP TYPE ` + typeName + `_ot CASCADE`,
+		`DROP TYPE ` + typeName + `_lt CASCADE`,
+	}
+	for _, del := range dels {
+		testDb.ExecContext(ctx, del)
+	}
+	for _, ddl := range []string{
+		`CREATE OR REPLACE TYPE ` + typeName + `_lt FORCE AS TABLE OF VARCHAR2(20)`,
+		`CREATE OR REPLACE TYPE ` + typeName + `_ot FORCE AS OBJECT (id NUMBER(9), name VARCHAR2(20))`,
+		`CREATE OR REPLACE TYPE ` + typeName + `_tab FORCE AS TABLE OF ` + typeName + `_ot`,
+		`CREATE OR REPLACE TYPE ` + typeName + `_grp FORCE AS OBJECT (name VARCHAR2(20), members ` + typeName + `_tab)`,
+		`CREATE OR REPLACE PROCEDURE ` + typeName + `_modify(
+  p_list IN OUT ` + typeName + `_lt, p_obj IN OUT ` + typeName + `_ot, p_tab OUT ` + typeName + `_tab) IS
+BEGIN
+  p_list.EXTEND;
+  p_list(p_list.LAST) := 'added';
+  p_obj.name := UPPER(p_obj.name);
+  p_tab := ` + typeName + `_tab(` + typeName + `_ot(1, 'one'), ` + typeName + `_ot(2, 'two'));
+END;`,
+	} {
+		if _, err := testDb.ExecContext(ctx, ddl); err != nil {
+			t.Fatalf("%s: %+v", ddl, err)
+		}
+	}
+	defer func() {
+		for _, del := range dels {
+			_, _ = testDb.ExecContext(context.Background(), del)
+		}
+	}()
+
+	type rec struct {
+		Name string `godror:"NAME"`
+		ID   int    `godror:"ID"`
+	}
+	list := godror.Collection[string]{TypeName: typeName + "_lt", Items: []string{"a", "b"}}
+	obj := godror.ObjectOf[rec]{TypeName: typeName + "_ot", Value: rec{ID: 3, Name: "three"}}
+	tab := godror.Collection[rec]{TypeName: typeName + "_tab"}
+	if _, err := testDb.ExecContext(ctx, "BEGIN "+typeName+"_modify(:1, :2, :3); END;",
+		sql.Out{Dest: &list, In: true}, sql.Out{Dest: &obj, In: true}, sql.Out{Dest: &tab},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff([]string{"a", "b", "added"}, list.Items); d != "" {
+		t.Error("list:", d)
+	}
+	if d := cmp.Diff(rec{ID: 3, Name: "THREE"}, obj.Value); d != "" {
+		t.Error("obj:", d)
+	}
+	if d := cmp.Diff([]rec{{ID: 1, Name: "one"}, {ID: 2, Name: "two"}}, tab.Items); d != "" {
+		t.Error("tab:", d)
+	}
+
+	scanned := godror.Collection[string]{TypeName: typeName + "_lt"}
+	if err := testDb.QueryRowContext(ctx,
+		"SELECT "+typeName+"_lt('x', 'y') FROM DUAL",
+	).Scan(&scanned); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff([]string{"x", "y"}, scanned.Items); d != "" {
+		t.Error("scanned:", d)
+	}
+
+	// Scan has no connection, so the nested collection's type must come from the object.
+	type grp struct {
+		Name    string `godror:"NAME"`
+		Members []rec  `godror:"MEMBERS,type=TEST_GENERIC_TAB"`
+	}
+	group := godror.ObjectOf[grp]{TypeName: typeName + "_grp"}
+	if err := testDb.QueryRowContext(ctx,
+		"SELECT "+typeName+"_grp('g', "+typeName+"_tab("+typeName+"_ot(1, 'one'), "+typeName+"_ot(2, 'two'))) FROM DUAL",
+	).Scan(&group); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(grp{Name: "g", Members: []rec{{ID: 1, Name: "one"}, {ID: 2, Name: "two"}}}, group.Value); d != "" {
+		t.Error("nested:", d)
+	}
+}
+
 func prepExec(ctx context.Context, testCon driver.ConnPrepareContext, qry string, args ...driver.NamedValue) error {
 	stmt, err := testCon.PrepareContext(ctx, qry)
 	if err != nil {
@@ -1341,6 +1745,136 @@ func TestSubObjectTypeClose(t *testing.T) {
 	}
 }
 
+func TestDescribeObjectType(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("DescribeObjectType"), 30*time.Second)
+	defer cancel()
+	const typeName = "test_describe"
+	dels := []string{
+		`DROP TYPE ` + typeName + `_ot CASCADE`,
+		`DROP TYPE ` + typeName + `_lt CASCADE`,
+	}
+	for _, del := range dels {
+		testDb.ExecContext(ctx, del)
+	}
+	for _, ddl := range []string{
+		`CREATE OR REPLACE TYPE ` + typeName + `_lt FORCE AS VARRAY(30) OF VARCHAR2(30);`,
+		`CREATE OR REPLACE TYPE ` + typeName + `_ot FORCE AS OBJECT (
+     id NUMBER(10,2),
+	 list ` + typeName + `_lt);`,
+	} {
+		if _, err := testDb.ExecContext(ctx, ddl); err != nil {
+			t.Fatalf("%s: %+v", ddl, err)
+		}
+	}
+	defer func() {
+		for _, del := range dels {
+			_, _ = testDb.ExecContext(context.Background(), del)
+		}
+	}()
+
+	describe := func() godror.ObjectTypeDescription {
+		t.Helper()
+		cx, err := testDb.Conn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer cx.Close()
+		desc, err := godror.DescribeObjectType(ctx, cx, typeName+"_ot")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return desc
+	}
+	desc := describe()
+	b, err := json.Marshal(desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%s", b)
+	if !desc.IsObject() || len(desc.Attributes) != 2 {
+		t.Fatalf("got %+v", desc)
+	}
+	if a := desc.Attributes[0]; a.Name != "ID" || a.Type.DatabaseType != "NUMBER" || a.Type.Precision != 10 || a.Type.Scale != 2 {
+		t.Errorf("ID: got %+v", a)
+	}
+	if a := desc.Attributes[1]; a.Name != "LIST" || a.Sequence != 1 ||
+		a.Type.CollectionOf == nil || a.Type.CollectionOf.DatabaseType != "VARCHAR2" || a.Type.CollectionOf.CharSize != 30 {
+		t.Errorf("LIST: got %+v", a)
+	}
+	var got godror.ObjectTypeDescription
+	if err = json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(desc, got); d != "" {
+		t.Errorf("JSON round trip: %s", d)
+	}
+	if d := cmp.Diff(desc, describe()); d != "" {
+		t.Errorf("cached: %s", d)
+	}
+
+	if _, err = testDb.ExecContext(ctx, `ALTER TYPE `+typeName+`_ot ADD ATTRIBUTE (nm VARCHAR2(10)) CASCADE`); err != nil {
+		t.Fatal(err)
+	}
+	if err = godror.InvalidateObjectTypeCache(ctx, testDb, typeName+"_ot"); err != nil {
+		t.Fatal(err)
+	}
+	if desc = describe(); len(desc.Attributes) != 3 {
+		t.Errorf("after invalidation: got %+v", desc)
+	}
+}
+
+func TestSubscribeObjectTypeChanges(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext("SubscribeObjectTypeChanges"), 60*time.Second)
+	defer cancel()
+	const typeName = "test_subscr_ot"
+	del := `DROP TYPE ` + typeName + ` FORCE`
+	testDb.ExecContext(ctx, del)
+	if _, err := testDb.ExecContext(ctx, `CREATE OR REPLACE TYPE `+typeName+` FORCE AS OBJECT (id NUMBER(10))`); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _, _ = testDb.ExecContext(context.Background(), del) }()
+
+	cx, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cx.Close()
+	s, err := godror.SubscribeObjectTypeChanges(ctx, cx)
+	if err != nil {
+		var ec interface{ Code() int }
+		if strings.Contains(err.Error(), "enableEvents") ||
+			errors.As(err, &ec) && slices.Contains([]int{1031, 29970, 29972, 29983, 65131}, ec.Code()) {
+			t.Skip(err)
+		}
+		t.Fatalf("%+v", err)
+	}
+	defer s.Close()
+
+	if desc, err := godror.DescribeObjectType(ctx, cx, typeName); err != nil {
+		t.Fatal(err)
+	} else if len(desc.Attributes) != 1 {
+		t.Fatalf("got %+v", desc)
+	}
+	if _, err = testDb.ExecContext(ctx, `ALTER TYPE `+typeName+` ADD ATTRIBUTE (nm VARCHAR2(10)) CASCADE`); err != nil {
+		t.Fatal(err)
+	}
+	// no InvalidateObjectTypeCache: the notification drops the cached description
+	for {
+		desc, err := godror.DescribeObjectType(ctx, cx, typeName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(desc.Attributes) == 2 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("not notified: got %+v", desc)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
 func TestObjectGetList(t *testing.T) {
 	t.Parallel()
 	tblSuffix := "_OL_" + tblSuffix
@@ -2501,6 +2501,126 @@ func TestImplicitResults(t *testing.T) {
 	}
 }
 
+func TestStructToOrderedExec(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("StructToOrderedExec"), 10*time.Second)
+	defer cancel()
+	type params struct {
+		In    string `db:"in"`
+		InOut *int64 `godror:"in_out"`
+		Out   *string
+	}
+	n := int64(2)
+	p := params{In: "a", InOut: &n}
+	qry, args, err := godror.StructToOrdered(`BEGIN :in_out := :in_out * 3; :out := :in||:in_out; END;`, &p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = testDb.ExecContext(ctx, qry, args...); err != nil {
+		t.Fatalf("%s %v: %+v", qry, args, err)
+	}
+	if n != 6 || p.Out == nil || *p.Out != "a6" {
+		t.Errorf("got InOut=%d Out=%v", n, p.Out)
+	}
+}
+
+func TestScanAllStructs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("ScanAllStructs"), 10*time.Second)
+	defer cancel()
+	type row struct {
+		Dt   time.Time `godror:"DT"`
+		Name string    `db:"NAME"`
+		Num  int       `godror:"NUM"`
+		Opt  *float64
+	}
+	const sel = `SELECT LEVEL AS num, 'n'||LEVEL AS name, TRUNC(SYSDATE) AS dt, DECODE(LEVEL, 2, 0.5) AS opt
+  FROM DUAL CONNECT BY LEVEL <= 3`
+	check := func(t *testing.T, rs []row) {
+		t.Helper()
+		if len(rs) != 3 {
+			t.Fatalf("got %d rows, wanted 3: %+v", len(rs), rs)
+		}
+		for i, r := range rs {
+			if r.Num != i+1 || r.Name != fmt.Sprintf("n%d", i+1) || r.Dt.IsZero() {
+				t.Errorf("%d. got %+v", i, r)
+			}
+			if (r.Opt != nil) != (i == 1) || r.Opt != nil && *r.Opt != 0.5 {
+				t.Errorf("%d. got Opt=%v", i, r.Opt)
+			}
+		}
+	}
+
+	t.Run("RefCursor", func(t *testing.T) {
+		var rset driver.Rows
+		if _, err := testDb.ExecContext(ctx, "BEGIN OPEN :1 FOR "+sel+"; END;", sql.Out{Dest: &rset}); err != nil {
+			t.Fatal(err)
+		}
+		defer rset.Close()
+		rs, err := godror.ScanAll[row](ctx, rset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, rs)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		rows, err := testDb.QueryContext(ctx, sel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var rs []row
+		for r, err := range godror.ScanEachSQL[row](rows) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			rs = append(rs, r)
+		}
+		check(t, rs)
+	})
+
+	t.Run("ImplicitResults", func(t *testing.T) {
+		rows, err := testDb.QueryContext(ctx, `DECLARE
+  c1 SYS_REFCURSOR;
+  c2 SYS_REFCURSOR;
+BEGIN
+  OPEN c1 FOR `+sel+`;
+  DBMS_SQL.RETURN_RESULT(c1);
+  OPEN c2 FOR `+sel+`;
+  DBMS_SQL.RETURN_RESULT(c2);
+END;`)
+		if err != nil {
+			if strings.Contains(err.Error(), "PLS-00302:") {
+				t.Skip(err)
+			}
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var n int
+		for {
+			rs, err := godror.ScanAllSQL[row](rows)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The PL/SQL block itself has no rows, only the implicit results.
+			if len(rs) != 0 {
+				check(t, rs)
+				n++
+			}
+			if !rows.NextResultSet() {
+				break
+			}
+		}
+		if err = rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if n != 2 {
+			t.Errorf("got %d result sets, wanted 2", n)
+		}
+	})
+}
+
 func TestStartupShutdown(t *testing.T) {
 	ensureSystemDB(t)
 	if os.Getenv("GODROR_DB_SHUTDOWN") != "1" {
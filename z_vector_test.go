@@ -475,3 +475,55 @@ func TestVectorPointerCases(t *testing.T) {
 		compareSparseVector(t, id, sparse2, sparseVec2)
 	}
 }
+
+// It Verifies binding and scanning plain slices as VECTOR with the VectorSlices option.
+func TestVectorSlices(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(testContext("VectorSlices"), 30*time.Second)
+	defer cancel()
+	conn, err := testDb.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	tbl := "test_vector_slices" + tblSuffix
+	conn.ExecContext(ctx, "DROP TABLE "+tbl)
+	if _, err = conn.ExecContext(ctx,
+		"CREATE TABLE "+tbl+" (id NUMBER(6), f32 Vector(3, float32), f64 Vector(3, float64))", //nolint:gas
+	); err != nil {
+		if errIs(err, 902, "invalid datatype") {
+			t.Skip(err)
+		}
+		t.Fatal(err)
+	}
+	defer testDb.Exec("DROP TABLE " + tbl)
+
+	f32, f64 := []float32{1.5, 2, -3}, []float64{0.25, 0, 1e10}
+	if _, err = conn.ExecContext(ctx, "INSERT INTO "+tbl+" (id, f32, f64) VALUES (:1, :2, :3)", //nolint:gas
+		1, f32, f64, godror.VectorSlices(),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	var got32 []float32
+	var got64 []float64
+	qry := "SELECT f32, f64 FROM " + tbl + " WHERE id = 1" //nolint:gas
+	if err = conn.QueryRowContext(ctx, qry, godror.VectorSlices()).Scan(&got32, &got64); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got32, f32) || !reflect.DeepEqual(got64, f64) {
+		t.Errorf("got %v, %v, wanted %v, %v", got32, got64, f32, f64)
+	}
+
+	var v godror.Vector
+	if err = conn.QueryRowContext(ctx, "SELECT f64 FROM "+tbl+" WHERE id = 1").Scan(&v); err != nil { //nolint:gas
+		t.Fatal(err)
+	}
+	if fs, err := v.Float32s(); err != nil {
+		t.Fatal(err)
+	} else if want := []float32{0.25, 0, 1e10}; !reflect.DeepEqual(fs, want) {
+		t.Errorf("Float32s: got %v, wanted %v", fs, want)
+	}
+	t.Logf("text: %s", v)
+}